@@ -16,6 +16,9 @@ package sqlx
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"slices"
 	"sync"
 )
 
@@ -27,22 +30,38 @@ var argspool = sync.Pool{
 }
 
 func getargs() *ArgsBuilder  { return argspool.Get().(*ArgsBuilder) }
-func putargs(a *ArgsBuilder) { a.Reset(); argspool.Put(a) }
+func putargs(a *ArgsBuilder) { a.Reset(); a.simplifyIn = false; argspool.Put(a) }
 
 // DefaultArgsCap is the default capacity to be allocated for ArgsBuilder.
 var DefaultArgsCap = 32
 
+// SetDefaultArgsCap resets the default capacity of the arguments
+// allocated for the ArgsBuilder acquired from pool.
+//
+// It should be called only during initialization.
+func SetDefaultArgsCap(n int) {
+	if n <= 0 {
+		panic("sqlx.SetDefaultArgsCap: the capacity must be greater than 0")
+	}
+	DefaultArgsCap = n
+}
+
 // ArgsBuilder is used to build the arguments.
 type ArgsBuilder struct {
 	Dialect
 
 	args []any
 	pool bool
+
+	simplifyIn bool // Build the singleton IN and NOT IN as = and <>
 }
 
 // GetArgsBuilderFromPool acquires an ArgsBuilder with the dialect from pool.
 func GetArgsBuilderFromPool(dialect Dialect) *ArgsBuilder {
 	a := getargs()
+	if cap(a.args) < DefaultArgsCap {
+		a.args = make([]any, 0, DefaultArgsCap)
+	}
 	a.Dialect = dialect
 	return a
 }
@@ -66,6 +85,13 @@ func (a *ArgsBuilder) Reset() {
 	a.args = a.args[:0]
 }
 
+// Grow grows the capacity of the arguments to guarantee space for another n ones.
+func (a *ArgsBuilder) Grow(n int) {
+	if n > 0 {
+		a.args = slices.Grow(a.args, n)
+	}
+}
+
 // Add appends the argument and returns the its placeholder.
 //
 // If arg is the type of sql.NamedArg, it will use @arg.Name as the placeholder
@@ -80,6 +106,14 @@ func (a *ArgsBuilder) Add(arg any) (placeholder string) {
 	return a.Placeholder(len(a.args))
 }
 
+// Len returns the number of the added arguments.
+func (a *ArgsBuilder) Len() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.args)
+}
+
 // Args returns the added arguments.
 func (a *ArgsBuilder) Args() (args []any) {
 	if a != nil {
@@ -87,3 +121,26 @@ func (a *ArgsBuilder) Args() (args []any) {
 	}
 	return
 }
+
+// Validate is equal to ValidateArgs(a.Args()).
+func (a *ArgsBuilder) Validate() error {
+	return ValidateArgs(a.Args())
+}
+
+// ValidateArgs checks whether each argument is acceptable by the driver
+// by driver.DefaultParameterConverter, and returns the error naming
+// the index and type of the first unacceptable argument.
+//
+// For sql.NamedArg, its value is checked instead.
+func ValidateArgs(args []any) error {
+	for i, arg := range args {
+		if na, ok := arg.(sql.NamedArg); ok {
+			arg = na.Value
+		}
+
+		if _, err := driver.DefaultParameterConverter.ConvertValue(arg); err != nil {
+			return fmt.Errorf("sqlx: the argument %d of type %T is unacceptable by the driver: %w", i, arg, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func BenchmarkArgsBuilder3000Args(b *testing.B) {
+	const n = 3000
+	var v any = 1
+
+	b.Run("Append", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			args := &ArgsBuilder{Dialect: MySQL}
+			for j := 0; j < n; j++ {
+				args.Add(v)
+			}
+		}
+	})
+
+	b.Run("Grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			args := &ArgsBuilder{Dialect: MySQL}
+			args.Grow(n)
+			for j := 0; j < n; j++ {
+				args.Add(v)
+			}
+		}
+	})
+}
+
+func TestSetDefaultArgsCap(t *testing.T) {
+	defer SetDefaultArgsCap(DefaultArgsCap)
+
+	SetDefaultArgsCap(100)
+	args := GetArgsBuilderFromPool(MySQL)
+	defer args.Release()
+
+	if c := cap(args.Args()); c < 100 {
+		t.Errorf("expect the capacity at least 100, but got %d", c)
+	}
+}
+
+func TestArgsBuilderValidate(t *testing.T) {
+	type point struct{ X, Y int }
+
+	args := GetArgsBuilderFromPool(MySQL)
+	defer args.Release()
+
+	args.Add(1)
+	args.Add("abc")
+	if err := args.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	args.Add(point{1, 2})
+	err := args.Validate()
+	if err == nil || !strings.Contains(err.Error(), "argument 2 of type sqlx.point") {
+		t.Errorf("expect an error naming the argument 2, but got '%v'", err)
+	}
+
+	db, tdb := newTestDB(MySQL, nil)
+	_, err = db.WithArgsValidation().Update().Table("user").Set(op.Set("point", point{1, 2})).Where(op.Equal("id", 1)).Exec()
+	if err == nil || !strings.Contains(err.Error(), "argument 0 of type sqlx.point") {
+		t.Errorf("expect an error naming the argument 0, but got '%v'", err)
+	}
+	if stmts := tdb.Statements(); len(stmts) != 0 {
+		t.Errorf("expect no executed statements, but got %q", stmts)
+	}
+
+	// The arguments added by the interceptor are validated, and so is the query.
+	vdb := db.WithArgsValidation()
+	vdb.Interceptor = InterceptorFunc(func(query string, args []any) (string, []any, error) {
+		return query + " AND point=?", append(args, point{1, 2}), nil
+	})
+	err = vdb.Select("id").From("user").Where(op.Equal("id", 1)).QueryRows().Bind(new([]int64))
+	if err == nil || !strings.Contains(err.Error(), "argument 1 of type sqlx.point") {
+		t.Errorf("expect an error naming the argument 1, but got '%v'", err)
+	}
+	if stmts := tdb.Statements(); len(stmts) != 0 {
+		t.Errorf("expect no executed statements, but got %q", stmts)
+	}
+}
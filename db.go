@@ -63,6 +63,13 @@ var DefaultConfigs = []Config{MaxOpenConns(0), ConnMaxIdleTime(time.Minute * 5)}
 // DefaultOpener is used to open a *sql.DB.
 var DefaultOpener Opener = sql.Open
 
+// DefaultInterceptor is the default interceptor set on the DB created by Open,
+// which can be overridden by resetting DB.Interceptor.
+// Use ChainInterceptors to set more than one interceptor.
+//
+// It should be set only during initialization.
+var DefaultInterceptor Interceptor
+
 // MaxOpenConns returns a Config to set the maximum number of the open connection.
 //
 // If maxnum is equal to 0, it is runtime.NumCPU()*2 by default.
@@ -93,12 +100,24 @@ type DB struct {
 	Dialect
 	Executor
 	Interceptor
+
+	observer func(query string, rows int, dur time.Duration)
+	validate bool          // Validate the arguments before executing the statement
+	timeout  time.Duration // The default timeout of the context without deadline
+	stmts    *stmtCache    // The cache of the prepared statements
+
+	slowthreshold time.Duration
+	slowhook      func(ctx context.Context, query string, args []any, elapsed time.Duration)
 }
 
 // Open opens a database specified by its database driver name
 // and a driver-specific data source name,
+//
+// The dialect is the one registered for the driver by RegisterDriverDialect,
+// or the registered one named driverName. And the interceptor of the returned
+// DB is DefaultInterceptor.
 func Open(driverName, dataSourceName string, configs ...Config) (*DB, error) {
-	dialect := GetDialect(driverName)
+	dialect := getDriverDialect(driverName)
 	if dialect == nil {
 		return nil, fmt.Errorf("the dialect '%s' has not been registered",
 			driverName)
@@ -116,7 +135,7 @@ func Open(driverName, dataSourceName string, configs ...Config) (*DB, error) {
 		c(db)
 	}
 
-	xdb := &DB{Dialect: dialect, Executor: db}
+	xdb := &DB{Dialect: dialect, Executor: db, Interceptor: DefaultInterceptor, stmts: new(stmtCache)}
 	return xdb, nil
 }
 
@@ -133,13 +152,32 @@ func (db *DB) Reset(other *DB) {
 		db.Dialect = nil
 		db.Executor = nil
 		db.Interceptor = nil
+		db.observer = nil
+		db.validate = false
+		db.timeout = 0
+		db.stmts = nil
+		db.slowthreshold = 0
+		db.slowhook = nil
 	} else {
 		db.Dialect = other.Dialect
 		db.Executor = other.Executor
 		db.Interceptor = other.Interceptor
+		db.observer = other.observer
+		db.validate = other.validate
+		db.timeout = other.timeout
+		db.stmts = other.stmts
+		db.slowthreshold = other.slowthreshold
+		db.slowhook = other.slowhook
 	}
 }
 
+// withExecutor returns a copy of db with the new executor.
+func (db *DB) withExecutor(executor Executor) *DB {
+	ndb := *db
+	ndb.Executor = executor
+	return &ndb
+}
+
 // GetDialect returns the dialect of the db.
 //
 // If not set, return DefaultDialect instead.
@@ -160,6 +198,16 @@ func (db *DB) Intercept(sql string, args []any) (string, []any, error) {
 	return sql, args, nil
 }
 
+// intercept intercepts the sql statement and arguments,
+// then validates the intercepted arguments if enabled.
+func (db *DB) intercept(query string, args []any) (string, []any, error) {
+	query, args, err := db.Intercept(query, args)
+	if err == nil && db.validate {
+		err = ValidateArgs(args)
+	}
+	return query, args, err
+}
+
 // Exec is equal to db.ExecContext(context.Background(), query, args...).
 func (db *DB) Exec(query string, args ...any) (r sql.Result, err error) {
 	return db.ExecContext(context.Background(), query, args...)
@@ -175,18 +223,106 @@ func (db *DB) QueryRow(query string, args ...any) *sql.Row {
 	return db.QueryRowContext(context.Background(), query, args...)
 }
 
+// WithArgsValidation returns a copy of db, which validates the arguments
+// intercepted by the interceptor with ValidateArgs before executing
+// the statement when calling ExecContext or QueryContext, so that
+// the unsupported argument fails with a clear error instead of
+// an opaque one inside the driver.
+//
+// QueryRowContext does not validate the arguments, because *sql.Row
+// cannot carry the error. Use QueryContext instead.
+//
+// Notice: the driver may support more types than ValidateArgs,
+// such as by implementing the interface driver.NamedValueChecker.
+func (db *DB) WithArgsValidation() *DB {
+	ndb := *getDB(db)
+	ndb.validate = true
+	return &ndb
+}
+
+// WithQueryTimeout returns a copy of db, which wraps the context
+// by context.WithTimeout with the timeout d if the context has no deadline,
+// which is used as a safety net against the hanging statements.
+//
+// The timeout is applied to ExecContext and ExecPrepared, and the queries
+// returning Rows or Row, such as QueryRowsContext, QueryRowOneContext and
+// the QueryRowsContext and QueryRowContext of the builders, whose context
+// is released when the rows are closed by Rows.Close, Rows.Bind, Row.Scan,
+// Row.Bind, etc.
+//
+// The context with the deadline is used as it is, which is not shortened.
+// If d is less than or equal to 0, disable the default timeout.
+//
+// Notice: QueryContext, QueryRowContext and QueryPrepared return
+// *sql.Rows or *sql.Row, which cannot release the context when closed,
+// so the timeout is not applied to them. Use QueryRowsContext or
+// QueryRowOneContext instead, or pass the context with the deadline.
+func (db *DB) WithQueryTimeout(d time.Duration) *DB {
+	ndb := *getDB(db)
+	ndb.timeout = d
+	return &ndb
+}
+
+func (db *DB) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if db.timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			return context.WithTimeout(ctx, db.timeout)
+		}
+	}
+	return ctx, func() {}
+}
+
+// SetSlowQueryHook sets the hook called with the final sql statement
+// and arguments after intercepted when the elapsed time of ExecContext,
+// QueryContext or QueryRowContext is not less than threshold.
+//
+// For the query, the elapsed time does not include reading the rows.
+// If fn is nil, disable the hook.
+func (db *DB) SetSlowQueryHook(threshold time.Duration, fn func(ctx context.Context, query string, args []any, elapsed time.Duration)) {
+	db.slowthreshold = threshold
+	db.slowhook = fn
+}
+
+func (db *DB) checkSlowQuery(ctx context.Context, start time.Time, query string, args []any) {
+	if db.slowhook != nil {
+		if elapsed := time.Since(start); elapsed >= db.slowthreshold {
+			db.slowhook(ctx, query, args, elapsed)
+		}
+	}
+}
+
 // ExecContext executes the sql statement.
 func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (r sql.Result, err error) {
-	if query, args, err = db.Intercept(query, args); err == nil {
-		r, err = db.Executor.ExecContext(ctx, query, args...)
-	}
-	return
+	return db.exec(ctx, query, args, db.Executor.ExecContext)
 }
 
 // QueryContext executes the query sql statement.
 func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (rows *sql.Rows, err error) {
-	if query, args, err = db.Intercept(query, args); err == nil {
-		rows, err = db.Executor.QueryContext(ctx, query, args...)
+	return db.query(ctx, query, args, db.Executor.QueryContext)
+}
+
+// exec intercepts and validates the arguments, then executes the statement
+// by the function exec with the timeout and the slow query hook.
+func (db *DB) exec(ctx context.Context, query string, args []any,
+	exec func(context.Context, string, ...any) (sql.Result, error)) (r sql.Result, err error) {
+	if query, args, err = db.intercept(query, args); err == nil {
+		ctx, cancel := db.withTimeout(ctx)
+		defer cancel()
+
+		start := time.Now()
+		r, err = exec(ctx, query, args...)
+		db.checkSlowQuery(ctx, start, query, args)
+	}
+	return
+}
+
+// query is the same as exec, but for the query statement without the timeout.
+func (db *DB) query(ctx context.Context, query string, args []any,
+	exec func(context.Context, string, ...any) (*sql.Rows, error)) (rows *sql.Rows, err error) {
+	if query, args, err = db.intercept(query, args); err == nil {
+		start := time.Now()
+		rows, err = exec(ctx, query, args...)
+		db.checkSlowQuery(ctx, start, query, args)
 	}
 	return
 }
@@ -197,5 +333,9 @@ func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *s
 	if err != nil {
 		panic(err)
 	}
-	return db.Executor.QueryRowContext(ctx, query, args...)
+
+	start := time.Now()
+	row := db.Executor.QueryRowContext(ctx, query, args...)
+	db.checkSlowQuery(ctx, start, query, args)
+	return row
 }
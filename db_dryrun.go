@@ -0,0 +1,50 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+)
+
+// WithDryRun returns a copy of db, which calls fn with the intercepted
+// sql statement and its arguments instead of executing it when calling
+// ExecContext, and returns a zero result.
+//
+// The query statements, such as SELECT, are still executed by db.
+func (db *DB) WithDryRun(fn func(query string, args []any)) *DB {
+	if fn == nil {
+		panic("sqlx.DB.WithDryRun: the dry-run function must not be nil")
+	}
+
+	db = getDB(db)
+	return db.withExecutor(dryRunExecutor{Executor: db.Executor, dryrun: fn})
+}
+
+type dryRunExecutor struct {
+	Executor
+	dryrun func(query string, args []any)
+}
+
+func (e dryRunExecutor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	e.dryrun(query, slices.Clone(args)) // The args may be reused after returning.
+	return dryRunResult{}, nil
+}
+
+type dryRunResult struct{}
+
+func (dryRunResult) LastInsertId() (int64, error) { return 0, nil }
+func (dryRunResult) RowsAffected() (int64, error) { return 0, nil }
@@ -0,0 +1,54 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestDBWithDryRun(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+
+	var queries []string
+	var arguments [][]any
+	dryrun := db.WithDryRun(func(query string, args []any) {
+		queries = append(queries, query)
+		arguments = append(arguments, args)
+	})
+
+	result, err := dryrun.Delete().From("user").Where(op.Equal("id", 1)).Exec()
+	if err != nil {
+		t.Fatal(err)
+	} else if n, _ := result.RowsAffected(); n != 0 {
+		t.Errorf("expect 0 affected rows, but got %d", n)
+	}
+
+	expect := []string{"DELETE FROM `user` WHERE `id`=?"}
+	if !slices.Equal(expect, queries) {
+		t.Errorf("expect dry-run queries %q, but got %q", expect, queries)
+	}
+	if len(arguments) != 1 || !slices.Equal([]any{1}, arguments[0]) {
+		t.Errorf("expect dry-run args [[1]], but got %v", arguments)
+	}
+
+	_, _ = dryrun.Select("id").From("user").QueryRow().Bind(new(int))
+	expect = []string{"SELECT `id` FROM `user` LIMIT 1"}
+	if stmts := tdb.Statements(); !slices.Equal(expect, stmts) {
+		t.Errorf("expect statements %q, but got %q", expect, stmts)
+	}
+}
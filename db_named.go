@@ -0,0 +1,100 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// BindNamed replaces the named parameters ":name" in query with the
+// placeholders of the dialect in order, and returns the new query
+// and the positional arguments looked up from arg by the names.
+//
+// The Postgres cast "::type", the single-quoted string literals
+// and the double-quoted identifiers are skipped.
+// If a name is missing in arg, return an error.
+func BindNamed(dialect Dialect, query string, arg map[string]any) (string, []any, error) {
+	var buf strings.Builder
+	buf.Grow(len(query) + 8)
+
+	args := make([]any, 0, len(arg))
+	for i, _len := 0, len(query); i < _len; {
+		switch c := query[i]; {
+		case c == '\'' || c == '"':
+			end := skipQuotedLiteral(query, i)
+			buf.WriteString(query[i:end])
+			i = end
+
+		case c == ':' && i+1 < _len && query[i+1] == ':':
+			buf.WriteString("::")
+			i += 2
+
+		case c == ':' && i+1 < _len && isNameStart(query[i+1]):
+			end := i + 2
+			for end < _len && isNameChar(query[end]) {
+				end++
+			}
+
+			name := query[i+1 : end]
+			value, ok := arg[name]
+			if !ok {
+				return "", nil, fmt.Errorf("sqlx: missing the named argument '%s'", name)
+			}
+
+			args = append(args, value)
+			buf.WriteString(dialect.Placeholder(len(args)))
+			i = end
+
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+
+	return buf.String(), args, nil
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// NamedExecContext is the same as ExecContext, but uses the named
+// parameters ":name" in query, the values of which are looked up from arg.
+// See BindNamed.
+func (db *DB) NamedExecContext(ctx context.Context, query string, arg map[string]any) (sql.Result, error) {
+	query, args, err := BindNamed(db.GetDialect(), query, arg)
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
+
+// NamedQueryContext is the same as QueryContext, but uses the named
+// parameters ":name" in query, the values of which are looked up from arg.
+// See BindNamed.
+func (db *DB) NamedQueryContext(ctx context.Context, query string, arg map[string]any) (*sql.Rows, error) {
+	query, args, err := BindNamed(db.GetDialect(), query, arg)
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestBindNamed(t *testing.T) {
+	arg := map[string]any{"name": "abc", "age": 18, "id_1": 1}
+	tests := []struct {
+		dialect Dialect
+		query   string
+		expect  string
+		args    []any
+	}{
+		{
+			dialect: MySQL,
+			query:   "SELECT * FROM user WHERE name=:name AND age>:age",
+			expect:  "SELECT * FROM user WHERE name=? AND age>?",
+			args:    []any{"abc", 18},
+		},
+		{
+			dialect: Postgres,
+			query:   "SELECT id::text, ':age' FROM user WHERE id=:id_1 AND name=:name AND note<>'it''s :name'",
+			expect:  "SELECT id::text, ':age' FROM user WHERE id=$1 AND name=$2 AND note<>'it''s :name'",
+			args:    []any{1, "abc"},
+		},
+		{
+			dialect: Postgres,
+			query:   `SELECT "a:name" FROM "user" WHERE "x:age"=:age`,
+			expect:  `SELECT "a:name" FROM "user" WHERE "x:age"=$1`,
+			args:    []any{18},
+		},
+	}
+
+	for _, test := range tests {
+		sql, args, err := BindNamed(test.dialect, test.query, arg)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.dialect.Name(), err)
+		} else if sql != test.expect {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.expect, sql)
+		} else if !slices.Equal(test.args, args) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), test.args, args)
+		}
+	}
+
+	if _, _, err := BindNamed(MySQL, "SELECT * FROM user WHERE id=:id", arg); err == nil {
+		t.Errorf("expect an error for the missing argument, but got nil")
+	}
+}
+
+func TestDBNamedExecContext(t *testing.T) {
+	db, tdb := newTestDB(Postgres, nil)
+	query := `UPDATE "user" SET "name"=:name WHERE "id"=:id`
+	if _, err := db.NamedExecContext(context.Background(), query, map[string]any{"id": 1, "name": "abc"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{`UPDATE "user" SET "name"=$1 WHERE "id"=$2`}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	} else if args := tdb.Args(); len(args) != 1 || !slices.Equal(args[0], []any{"abc", int64(1)}) {
+		t.Errorf("unexpected args %v", args)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type stmtCache struct {
+	stmts sync.Map // query => *sql.Stmt
+}
+
+var stmtcachelock sync.Mutex
+
+// getStmtCache returns the statement cache, which is created if not exist.
+func (db *DB) getStmtCache() *stmtCache {
+	stmtcachelock.Lock()
+	defer stmtcachelock.Unlock()
+	if db.stmts == nil {
+		db.stmts = new(stmtCache)
+	}
+	return db.stmts
+}
+
+// canPrepare reports whether the executor supports the cached statements,
+// which is the root *sql.DB or the transaction started by it.
+func (db *DB) canPrepare() bool {
+	switch db.Executor.(type) {
+	case *sql.DB, txExecutor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Prepare returns the prepared statement of the query, which is prepared
+// only once and cached by the query for reuse until ClosePrepared or Close
+// is called. So the returned statement must not be closed by the caller.
+//
+// If the db is bound to a transaction, the cached statement is rebound
+// to the transaction by sql.Tx.StmtContext, or the statement is prepared
+// by the transaction without caching if not cached, both of which are
+// closed when the transaction ends.
+//
+// The statements are only cached for the executor of *sql.DB,
+// so it returns an error for other executors, such as *sql.Conn,
+// because the statement prepared on a single connection is dead
+// once the connection is closed.
+func (db *DB) Prepare(ctx context.Context, query string) (*sql.Stmt, error) {
+	switch e := db.Executor.(type) {
+	case *sql.DB:
+		cache := db.getStmtCache()
+		if stmt, ok := cache.stmts.Load(query); ok {
+			return stmt.(*sql.Stmt), nil
+		}
+
+		stmt, err := e.PrepareContext(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		if actual, loaded := cache.stmts.LoadOrStore(query, stmt); loaded {
+			_ = stmt.Close() // Prepared by others concurrently.
+			stmt = actual.(*sql.Stmt)
+		}
+		return stmt, nil
+
+	case txExecutor:
+		if db.stmts != nil {
+			if stmt, ok := db.stmts.stmts.Load(query); ok {
+				return e.StmtContext(ctx, stmt.(*sql.Stmt)), nil
+			}
+		}
+		return e.PrepareContext(ctx, query)
+
+	default:
+		return nil, fmt.Errorf("sqlx.DB.Prepare: the executor %T does not support to cache the prepared statement", db.Executor)
+	}
+}
+
+// ExecPrepared is the same as ExecContext, such as the interceptor,
+// the argument validation, the timeout and the slow query hook,
+// but executes the statement prepared and cached by Prepare.
+//
+// If the executor does not support the cached statements, such as
+// the dry-run or the connection-scoped transaction, it falls back to ExecContext.
+func (db *DB) ExecPrepared(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if !db.canPrepare() {
+		return db.ExecContext(ctx, query, args...)
+	}
+
+	return db.exec(ctx, query, args, func(ctx context.Context, query string, args ...any) (sql.Result, error) {
+		stmt, err := db.Prepare(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return stmt.ExecContext(ctx, args...)
+	})
+}
+
+// QueryPrepared is the same as QueryContext, such as the interceptor,
+// the argument validation and the slow query hook, but executes
+// the statement prepared and cached by Prepare.
+//
+// If the executor does not support the cached statements,
+// it falls back to QueryContext.
+func (db *DB) QueryPrepared(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if !db.canPrepare() {
+		return db.QueryContext(ctx, query, args...)
+	}
+
+	return db.query(ctx, query, args, func(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+		stmt, err := db.Prepare(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return stmt.QueryContext(ctx, args...)
+	})
+}
+
+// ClosePrepared closes and removes all the statements cached by Prepare.
+func (db *DB) ClosePrepared() error {
+	stmtcachelock.Lock()
+	cache := db.stmts
+	stmtcachelock.Unlock()
+	if cache == nil {
+		return nil
+	}
+
+	var errs []error
+	cache.stmts.Range(func(query, stmt any) bool {
+		cache.stmts.Delete(query)
+		if err := stmt.(*sql.Stmt).Close(); err != nil {
+			errs = append(errs, err)
+		}
+		return true
+	})
+	return errors.Join(errs...)
+}
+
+// Close closes the statements cached by Prepare and the executor.
+//
+// If the db is bound to a transaction, only close the executor,
+// because the cached statements belong to the original db.
+func (db *DB) Close() error {
+	if db.InTransaction() {
+		return db.Executor.Close()
+	}
+	return errors.Join(db.ClosePrepared(), db.Executor.Close())
+}
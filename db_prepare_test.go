@@ -0,0 +1,111 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql/driver"
+	"slices"
+	"testing"
+)
+
+func TestDBPrepare(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{Columns: []string{"id"}, Rows: [][]driver.Value{{int64(1)}}}, nil
+	})
+	ctx := context.Background()
+
+	const query = "SELECT `id` FROM `user` WHERE `id`=?"
+	for i := range 3 {
+		rows, err := db.QueryPrepared(ctx, query, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = rows.Close()
+	}
+
+	if _, err := db.ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.Transaction(ctx, func(tx *Tx) error {
+		_, err := tx.DB.ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", 2)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := tdb.prepares.Load(); n != 2 {
+		t.Errorf("expect %d prepared statements, but got %d", 2, n)
+	}
+
+	expects := []string{
+		query, query, query,
+		"DELETE FROM `user` WHERE `id`=?",
+		"BEGIN",
+		"DELETE FROM `user` WHERE `id`=?",
+		"COMMIT",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+
+	if err := db.ClosePrepared(); err != nil {
+		t.Fatal(err)
+	} else if _, err := db.ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", 3); err != nil {
+		t.Fatal(err)
+	} else if n := tdb.prepares.Load(); n != 3 {
+		t.Errorf("expect %d prepared statements, but got %d", 3, n)
+	}
+}
+
+func TestDBPrepareBypassCache(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+	ctx := context.Background()
+
+	var dryruns []string
+	dryrun := db.WithDryRun(func(query string, args []any) { dryruns = append(dryruns, query) })
+	if _, err := dryrun.ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", 1); err != nil {
+		t.Fatal(err)
+	} else if expects := []string{"DELETE FROM `user` WHERE `id`=?"}; !slices.Equal(expects, dryruns) {
+		t.Errorf("expect dry-run statements %q, but got %q", expects, dryruns)
+	}
+
+	err := db.TransactionConsistentSnapshot(ctx, func(tx *Tx) error {
+		if _, err := tx.DB.Prepare(ctx, "DELETE FROM `user`"); err == nil {
+			t.Errorf("expect an error to prepare on the connection-scoped transaction")
+		}
+		_, err := tx.DB.ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", 2)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := tdb.prepares.Load(); n != 0 {
+		t.Errorf("expect no prepared statements, but got %d", n)
+	}
+
+	expects := []string{"START TRANSACTION WITH CONSISTENT SNAPSHOT", "DELETE FROM `user` WHERE `id`=?", "COMMIT"}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+
+	type point struct{ X, Y int }
+	if _, err := db.WithArgsValidation().ExecPrepared(ctx, "DELETE FROM `user` WHERE `id`=?", point{}); err == nil {
+		t.Errorf("expect an error of the invalid argument")
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-op"
+)
+
+type deadlineExecutor struct {
+	Executor
+	deadline time.Time
+}
+
+func (e *deadlineExecutor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	e.deadline, _ = ctx.Deadline()
+	return nil, nil
+}
+
+func TestDBWithQueryTimeout(t *testing.T) {
+	executor := new(deadlineExecutor)
+	db := (&DB{Dialect: MySQL, Executor: executor}).WithQueryTimeout(time.Minute)
+
+	start := time.Now()
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM `user`"); err != nil {
+		t.Fatal(err)
+	} else if d := executor.deadline.Sub(start); d < time.Minute || d > time.Minute+time.Second {
+		t.Errorf("expect the deadline after %s, but got %s", time.Minute, d)
+	}
+
+	deadline := start.Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, "DELETE FROM `user`"); err != nil {
+		t.Fatal(err)
+	} else if !executor.deadline.Equal(deadline) {
+		t.Errorf("expect the deadline %s, but got %s", deadline, executor.deadline)
+	}
+
+	db = db.WithQueryTimeout(0)
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM `user`"); err != nil {
+		t.Fatal(err)
+	} else if !executor.deadline.IsZero() {
+		t.Errorf("expect no deadline, but got %s", executor.deadline)
+	}
+}
+
+type blockingExecutor struct{ Executor }
+
+func (e blockingExecutor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+type contextExecutor struct {
+	Executor
+	ctx context.Context
+}
+
+func (e *contextExecutor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	e.ctx = ctx
+	return e.Executor.QueryContext(ctx, query, args...)
+}
+
+func TestDBWithQueryTimeoutQuery(t *testing.T) {
+	db := (&DB{Dialect: MySQL, Executor: blockingExecutor{}}).WithQueryTimeout(time.Millisecond * 10)
+	if err := db.QueryRows("SELECT * FROM `user`").Err; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expect the error %v, but got %v", context.DeadlineExceeded, err)
+	}
+	if _, err := db.QueryRowOne("SELECT * FROM `user`").Bind(new(int)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expect the error %v, but got %v", context.DeadlineExceeded, err)
+	}
+
+	tdb, _ := newTestDB(MySQL, func(string, []any) (testresult, error) {
+		return testresult{Columns: []string{"id"}, Rows: [][]driver.Value{{int64(1)}}}, nil
+	})
+
+	executor := &contextExecutor{Executor: tdb.Executor}
+	db = tdb.WithQueryTimeout(time.Minute).withExecutor(executor)
+
+	var ids []int64
+	if err := db.Selects("id").From("user").QueryRows().Bind(&ids); err != nil {
+		t.Fatal(err)
+	} else if _, ok := executor.ctx.Deadline(); !ok {
+		t.Errorf("expect the deadline of the query context, but got none")
+	} else if err := executor.ctx.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expect the query context to be released after binding, but got %v", err)
+	}
+
+	var id int64
+	if _, err := db.Selects("id").From("user").QueryRow().Bind(&id); err != nil {
+		t.Fatal(err)
+	} else if err := executor.ctx.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expect the query context to be released after scanning, but got %v", err)
+	}
+
+	rows := db.Selects("id").From("user").QueryRows()
+	if rows.Err != nil {
+		t.Fatal(rows.Err)
+	} else if err := executor.ctx.Err(); err != nil {
+		t.Errorf("expect the query context not to be released before closing, but got %v", err)
+	}
+	_ = rows.Close()
+	if err := executor.ctx.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expect the query context to be released after closing, but got %v", err)
+	}
+}
+
+func TestDBSetSlowQueryHook(t *testing.T) {
+	db, _ := newTestDB(MySQL, nil)
+	db.Interceptor = InterceptorFunc(func(sql string, args []any) (string, []any, error) {
+		return sql + " /* slow */", args, nil
+	})
+
+	var queries []string
+	db.SetSlowQueryHook(0, func(ctx context.Context, query string, args []any, elapsed time.Duration) {
+		queries = append(queries, query)
+	})
+
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM `user`"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.QueryContext(context.Background(), "SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rows.Close()
+
+	if expect := []string{"DELETE FROM `user` /* slow */", "SELECT 1 /* slow */"}; !slices.Equal(expect, queries) {
+		t.Errorf("expect slow queries %q, but got %q", expect, queries)
+	}
+
+	queries = nil
+	db.SetSlowQueryHook(time.Hour, func(ctx context.Context, query string, args []any, elapsed time.Duration) {
+		queries = append(queries, query)
+	})
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM `user`"); err != nil {
+		t.Fatal(err)
+	} else if len(queries) > 0 {
+		t.Errorf("expect no slow queries, but got %q", queries)
+	}
+}
+
+func TestOpenWithDefaults(t *testing.T) {
+	tdb := new(testdatabase)
+	testdbmap.Store("testdb-open", tdb)
+
+	DefaultInterceptor = InterceptorFunc(func(sql string, args []any) (string, []any, error) {
+		return sql + " /* default */", args, nil
+	})
+	RegisterDriverDialect("sqlxtest", Postgres)
+	defer func() {
+		DefaultInterceptor = nil
+		RegisterDriverDialect("sqlxtest", nil)
+	}()
+
+	db, err := Open("sqlxtest", "testdb-open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err = db.Delete().From("user").Where(op.Equal("id", 1)).Exec(); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{`DELETE FROM "user" WHERE "id"=$1 /* default */`}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+
+	if _, err = Open("sqlxtest2", ""); err == nil {
+		t.Errorf("expect an error for the unregistered dialect, but got nil")
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DebugSQL returns the sql with the placeholders of the dialect substituted
+// by the quoted arguments, which is used to diagnose the mismatch between
+// the placeholders and arguments in the logs.
+//
+// The placeholder without the argument is kept as it is,
+// and the redundant arguments are appended as "/* extra args: ... */".
+//
+// Notice: it is only for logging and NOT SAFE to execute.
+func DebugSQL(dialect Dialect, sql string, args []any) string {
+	var buf strings.Builder
+	buf.Grow(len(sql) + len(args)*8)
+
+	placeholder := dialect.Placeholder(1)
+	prefix, numbered := strings.CutSuffix(placeholder, "1")
+	numbered = numbered && prefix != ""
+	if numbered {
+		placeholder = prefix
+	}
+
+	var next, maxn int
+	for i, _len := 0, len(sql); i < _len; {
+		switch {
+		case sql[i] == '\'':
+			end := skipQuotedLiteral(sql, i)
+			buf.WriteString(sql[i:end])
+			i = end
+
+		case strings.HasPrefix(sql[i:], placeholder):
+			start := i + len(placeholder)
+			end := start
+
+			index := next
+			if numbered {
+				for end < _len && sql[end] >= '0' && sql[end] <= '9' {
+					end++
+				}
+				if end == start {
+					buf.WriteString(placeholder)
+					i = end
+					continue
+				}
+				index, _ = strconv.Atoi(sql[start:end])
+				index--
+			} else {
+				next++
+			}
+
+			if index < len(args) {
+				buf.WriteString(debugArg(args[index]))
+				maxn = max(maxn, index+1)
+			} else {
+				buf.WriteString(sql[i:end])
+			}
+			i = end
+
+		default:
+			buf.WriteByte(sql[i])
+			i++
+		}
+	}
+
+	if maxn < len(args) {
+		buf.WriteString(" /* extra args: ")
+		for i, arg := range args[maxn:] {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(debugArg(arg))
+		}
+		buf.WriteString(" */")
+	}
+
+	return buf.String()
+}
+
+func debugArg(arg any) string {
+	if v, ok := arg.(driver.Valuer); ok {
+		value, err := v.Value()
+		if err != nil {
+			return fmt.Sprintf("<%T: %v>", arg, err)
+		}
+		arg = value
+	}
+
+	switch v := arg.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteDebugString(v)
+	case []byte:
+		return "X'" + hex.EncodeToString(v) + "'"
+	case time.Time:
+		return quoteDebugString(v.Format("2006-01-02 15:04:05.999999999Z07:00"))
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	default:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return "NULL"
+			}
+			return debugArg(rv.Elem().Interface())
+		}
+		return quoteDebugString(fmt.Sprint(v))
+	}
+}
+
+func quoteDebugString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestDebugSQL(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	q := Select("id").From("user").Where(
+		op.Equal("name", "it's"),
+		op.Equal("data", []byte("ab")),
+		op.Less("created_at", now),
+		op.Equal("age", 18),
+	)
+	expect := "SELECT `id` FROM `user` WHERE (`name`='it''s' AND `data`=X'6162' AND `created_at`<'2025-01-02 03:04:05Z' AND `age`=18)"
+	if sql := q.DebugSQL(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	sql := DebugSQL(Postgres, `UPDATE "user" SET "name"=$2, "tag"='$1' WHERE "id"=$1 AND "parent_id"=$3`, []any{1, nil})
+	expect = `UPDATE "user" SET "name"=NULL, "tag"='$1' WHERE "id"=1 AND "parent_id"=$3`
+	if sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	sql = DebugSQL(MySQL, "DELETE FROM `user` WHERE `id`=?", []any{1, true})
+	expect = "DELETE FROM `user` WHERE `id`=1 /* extra args: TRUE */"
+	if sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	args := GetArgsBuilderFromPool(MySQL)
+	defer args.Release()
+	args.Add(1)
+	args.Add(2)
+	if n := args.Len(); n != 2 {
+		t.Errorf("expect 2 args, but got %d", n)
+	}
+}
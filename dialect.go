@@ -16,7 +16,9 @@ package sqlx
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Dialect represents a dialect of the SQL.
@@ -36,9 +38,52 @@ type Dialect interface {
 
 	// LimitOffset returns the LIMIT OFFSET statement,
 	// such as "LIMIT n" or "LIMIT n OFFSET m" for MySQL and PostgreSQL.
+	//
+	// The limit equal to 0 means no limit, and the offset equal to 0 means
+	// no offset. So it returns "" if both limit and offset are equal to 0.
+	// And it should panic if limit or offset is negative.
 	LimitOffset(limit, offset int64) string
+
+	// IntervalExpr returns the interval literal of the duration,
+	// such as "INTERVAL 7 DAY" for MySQL and "INTERVAL '7 days'" for PostgreSQL,
+	// which is used by the date arithmetic, such as "NOW() - INTERVAL 7 DAY".
+	//
+	// It should panic if the dialect does not support the interval literal.
+	IntervalExpr(d time.Duration) string
+
+	// LockClause returns the row locking clause of the lock mode,
+	// such as "FOR UPDATE" for LockForUpdate.
+	//
+	// It should panic if the dialect does not support the lock mode.
+	LockClause(mode LockMode) string
+
+	// Capabilities returns the features supported by the dialect,
+	// which is used to check the support uniformly instead of the name.
+	Capabilities() Capabilities
+}
+
+// Capabilities represents the features supported by the dialect.
+type Capabilities struct {
+	SupportsReturning          bool // "INSERT/UPDATE/DELETE ... RETURNING ..."
+	SupportsDistinctOn         bool // "SELECT DISTINCT ON (...) ..."
+	SupportsArrays             bool // The array type and literal
+	SupportsLateral            bool // "JOIN LATERAL (...)"
+	SupportsWithTies           bool // "FETCH FIRST n ROWS WITH TIES"
+	SupportsRowValueComparison bool // "(a, b) > (?, ?)"
+	SupportsNullsOrder         bool // "ORDER BY ... NULLS FIRST/LAST"
 }
 
+// LockMode represents the mode of the row locking clause of SELECT.
+type LockMode string
+
+// Predefine some lock modes.
+const (
+	LockForUpdate      LockMode = "FOR UPDATE"
+	LockForShare       LockMode = "FOR SHARE"
+	LockForNoKeyUpdate LockMode = "FOR NO KEY UPDATE" // Only for PostgreSQL
+	LockForKeyShare    LockMode = "FOR KEY SHARE"     // Only for PostgreSQL
+)
+
 var dialects = make(map[string]Dialect, 4)
 
 // RegisterDialect registers the Dialect with the name.
@@ -59,10 +104,37 @@ func GetDialect(name string) Dialect {
 	return dialects[name]
 }
 
+var driverdialects = make(map[string]Dialect, 4)
+
+// RegisterDriverDialect registers the dialect used by Open for the driver,
+// which overrides the registered dialect named driverName,
+// such as the dialect Postgres for the driver "pgx".
+//
+// If dialect is nil, unregister it.
+//
+// It should be called only during initialization.
+func RegisterDriverDialect(driverName string, dialect Dialect) {
+	if dialect == nil {
+		delete(driverdialects, driverName)
+	} else {
+		driverdialects[driverName] = dialect
+	}
+}
+
+func getDriverDialect(driverName string) Dialect {
+	if dialect, ok := driverdialects[driverName]; ok {
+		return dialect
+	}
+	return GetDialect(driverName)
+}
+
 func init() {
 	RegisterDialect(MySQL, false)
 	RegisterDialect(Sqlite3, false)
 	RegisterDialect(Postgres, false)
+	RegisterDialect(SQLServer, false)
+	RegisterDialect(ClickHouse, false)
+	RegisterDialect(Oracle, false)
 }
 
 // DefaultDialect is the default dialect.
@@ -73,12 +145,29 @@ var (
 	MySQL    Dialect = dialect{mysqlDialect}
 	Sqlite3  Dialect = dialect{sqlite3Dialect}
 	Postgres Dialect = dialect{pqDialect}
+
+	// SQLServer is the dialect of Microsoft SQL Server.
+	//
+	// Notice: SQL Server requires ORDER BY for "OFFSET m ROWS FETCH NEXT n ROWS ONLY",
+	// so the SELECT statement with LIMIT or OFFSET must have ORDER BY.
+	SQLServer Dialect = dialect{sqlserverDialect}
+
+	// ClickHouse is the dialect of ClickHouse, which quotes the identifiers
+	// and formats the placeholders and LIMIT OFFSET like MySQL.
+	ClickHouse Dialect = dialect{clickhouseDialect}
+
+	// Oracle is the dialect of Oracle, which uses the bind variables ":i"
+	// and "OFFSET m ROWS FETCH NEXT n ROWS ONLY" for LIMIT OFFSET.
+	Oracle Dialect = dialect{oracleDialect}
 )
 
 const (
-	pqDialect      = "postgres"
-	mysqlDialect   = "mysql"
-	sqlite3Dialect = "sqlite3"
+	pqDialect         = "postgres"
+	mysqlDialect      = "mysql"
+	sqlite3Dialect    = "sqlite3"
+	sqlserverDialect  = "sqlserver"
+	clickhouseDialect = "clickhouse"
+	oracleDialect     = "oracle"
 )
 
 type dialect struct {
@@ -93,7 +182,11 @@ func (d dialect) Placeholder(i int) string {
 	switch d.name {
 	case pqDialect:
 		return fmt.Sprintf("$%d", i)
-	case mysqlDialect, sqlite3Dialect:
+	case sqlserverDialect:
+		return fmt.Sprintf("@p%d", i)
+	case oracleDialect:
+		return fmt.Sprintf(":%d", i)
+	case mysqlDialect, sqlite3Dialect, clickhouseDialect:
 		return "?"
 	}
 
@@ -102,20 +195,24 @@ func (d dialect) Placeholder(i int) string {
 
 func (d dialect) isQuoted(s string) bool {
 	switch d.name {
-	case pqDialect, sqlite3Dialect:
+	case pqDialect, sqlite3Dialect, oracleDialect:
 		return strings.IndexByte(s, '"') >= 0
-	case mysqlDialect:
+	case mysqlDialect, clickhouseDialect:
 		return strings.IndexByte(s, '`') >= 0
+	case sqlserverDialect:
+		return strings.IndexByte(s, '[') >= 0
 	}
 	panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
 }
 
 func (d dialect) quoteByDialect(s string) string {
 	switch d.name {
-	case pqDialect, sqlite3Dialect:
+	case pqDialect, sqlite3Dialect, oracleDialect:
 		return fmt.Sprintf(`"%s"`, s)
-	case mysqlDialect:
+	case mysqlDialect, clickhouseDialect:
 		return fmt.Sprintf("`%s`", s)
+	case sqlserverDialect:
+		return fmt.Sprintf("[%s]", s)
 	}
 
 	panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
@@ -148,6 +245,10 @@ func (d dialect) quote(s string) string {
 }
 
 func (d dialect) Quote(item string) string {
+	return quoteItem(d.name, item, d.quote)
+}
+
+func quoteItem(name, item string, quote func(string) string) string {
 	s := strings.TrimSpace(item)
 	if strings.IndexByte(s, ' ') >= 0 {
 		return s
@@ -155,32 +256,290 @@ func (d dialect) Quote(item string) string {
 
 	rightIndex := strings.IndexByte(s, ')')
 	if rightIndex < 0 {
-		return d.quote(s)
+		return quote(s)
 	}
 
 	leftIndex := strings.LastIndexByte(s, '(') + 1
 	if leftIndex < 1 {
-		panic(fmt.Errorf("Dialect(%s): invalid sql syntax: %s", d.name, item))
+		panic(fmt.Errorf("Dialect(%s): invalid sql syntax: %s", name, item))
 	}
 
 	return strings.Join([]string{
 		s[:leftIndex],
-		d.quote(s[leftIndex:rightIndex]),
+		quote(s[leftIndex:rightIndex]),
 		s[rightIndex:],
 	}, "")
 }
 
+// quoteCharDialect overrides the quote characters of the identifiers.
+type quoteCharDialect struct {
+	Dialect
+	open, close byte
+}
+
+func (d quoteCharDialect) Quote(item string) string {
+	return quoteItem(d.Name(), item, d.quote)
+}
+
+func (d quoteCharDialect) quote(s string) string {
+	if s == "*" || (dialect{}).isNumber(s) || strings.IndexByte(s, d.open) >= 0 {
+		return s
+	}
+
+	vs := strings.Split(s, ".")
+	for i, v := range vs {
+		vs[i] = string(d.open) + v + string(d.close)
+	}
+	return strings.Join(vs, ".")
+}
+
 func (d dialect) LimitOffset(limit, offset int64) string {
 	switch d.name {
-	case pqDialect, mysqlDialect, sqlite3Dialect:
+	case sqlserverDialect, oracleDialect:
+		return d.offsetFetch(limit, offset)
+	}
+
+	switch d.name {
+	case pqDialect, mysqlDialect, sqlite3Dialect, clickhouseDialect:
 		if limit < 0 {
 			panic("sqlx: the limit must be a positive integer")
 		}
-		if offset == 0 {
+		if offset < 0 {
+			panic("sqlx: the offset must be a positive integer")
+		}
+
+		switch {
+		case offset == 0 && limit == 0:
+			return ""
+
+		case offset == 0:
 			return fmt.Sprintf("LIMIT %d", limit)
+
+		case limit > 0:
+			return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+		// Only OFFSET without LIMIT
+		case d.name == pqDialect:
+			return fmt.Sprintf("OFFSET %d", offset)
+
+		case d.name == sqlite3Dialect:
+			return fmt.Sprintf("LIMIT -1 OFFSET %d", offset)
+
+		default: // MySQL and ClickHouse require LIMIT for OFFSET, so use the maximum.
+			return fmt.Sprintf("LIMIT 18446744073709551615 OFFSET %d", offset)
+		}
+	}
+
+	panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
+}
+
+// offsetFetch returns the "OFFSET m ROWS FETCH NEXT n ROWS ONLY" clause
+// for SQL Server and Oracle. Notice: SQL Server requires the ORDER BY clause.
+func (d dialect) offsetFetch(limit, offset int64) string {
+	if limit < 0 {
+		panic("sqlx: the limit must be a positive integer")
+	}
+	if offset < 0 {
+		panic("sqlx: the offset must be a positive integer")
+	}
+
+	switch {
+	case offset == 0 && limit == 0:
+		return ""
+
+	case limit == 0: // Only OFFSET without FETCH
+		return fmt.Sprintf("OFFSET %d ROWS", offset)
+
+	default:
+		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+	}
+}
+
+var intervalUnits = []struct {
+	unit time.Duration
+	name string
+}{
+	{time.Hour * 24, "DAY"},
+	{time.Hour, "HOUR"},
+	{time.Minute, "MINUTE"},
+	{time.Second, "SECOND"},
+	{time.Microsecond, "MICROSECOND"},
+}
+
+// splitInterval splits the duration into the number and the largest unit
+// which divides it exactly. The precision less than microsecond is dropped.
+func splitInterval(d time.Duration) (n int64, unit string) {
+	d = d.Truncate(time.Microsecond)
+	for _, u := range intervalUnits {
+		if d%u.unit == 0 {
+			return int64(d / u.unit), u.name
 		}
-		return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	}
+	panic("unreachable")
+}
+
+func (d dialect) Capabilities() Capabilities {
+	switch d.name {
+	case pqDialect:
+		return Capabilities{
+			SupportsReturning:          true,
+			SupportsDistinctOn:         true,
+			SupportsArrays:             true,
+			SupportsLateral:            true,
+			SupportsWithTies:           true,
+			SupportsRowValueComparison: true,
+			SupportsNullsOrder:         true,
+		}
+
+	case mysqlDialect:
+		return Capabilities{SupportsLateral: true, SupportsRowValueComparison: true}
+
+	case sqlite3Dialect:
+		return Capabilities{SupportsRowValueComparison: true, SupportsNullsOrder: true}
+
+	case clickhouseDialect:
+		return Capabilities{SupportsArrays: true, SupportsRowValueComparison: true, SupportsNullsOrder: true}
+
+	case oracleDialect:
+		return Capabilities{SupportsLateral: true, SupportsWithTies: true, SupportsNullsOrder: true}
+
+	case sqlserverDialect:
+		return Capabilities{}
+
+	default:
+		panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
+	}
+}
+
+func (d dialect) IntervalExpr(duration time.Duration) string {
+	n, unit := splitInterval(duration)
+	switch d.name {
+	case mysqlDialect, clickhouseDialect:
+		return fmt.Sprintf("INTERVAL %d %s", n, unit)
+
+	case pqDialect:
+		return fmt.Sprintf("INTERVAL '%d %ss'", n, strings.ToLower(unit))
+
+	case oracleDialect:
+		if unit == "MICROSECOND" {
+			return fmt.Sprintf("NUMTODSINTERVAL(%d/1000000, 'SECOND')", n)
+		}
+		return fmt.Sprintf("NUMTODSINTERVAL(%d, '%s')", n, unit)
+
+	case sqlite3Dialect, sqlserverDialect:
+		panic(fmt.Errorf("sqlx: the dialect '%s' does not support the interval literal", d.name))
 	}
 
 	panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
 }
+
+// RenumberPlaceholders rewrites the placeholders of the prebuilt sql fragment,
+// which are numbered from 1 by the dialect, such as "$1, $2" for PostgreSQL,
+// to be numbered from startIndex instead, and returns the new sql and the next
+// index, so that the fragments can be concatenated with the arguments in order.
+//
+// For the dialect whose placeholders are not numbered, such as "?" for MySQL,
+// the sql is returned as it is and the next index is increased by
+// the number of the placeholders.
+//
+// The placeholders in the single-quoted string literals are ignored.
+func RenumberPlaceholders(dialect Dialect, sql string, startIndex int) (string, int) {
+	if startIndex < 1 {
+		panic("sqlx.RenumberPlaceholders: the start index must be greater than 0")
+	}
+
+	prefix, numbered := strings.CutSuffix(dialect.Placeholder(1), "1")
+	if !numbered || prefix == "" {
+		return sql, startIndex + countPlaceholders(sql, dialect.Placeholder(1))
+	}
+
+	var buf strings.Builder
+	buf.Grow(len(sql) + 8)
+
+	next := startIndex
+	for i, _len := 0, len(sql); i < _len; {
+		switch {
+		case sql[i] == '\'':
+			end := skipQuotedLiteral(sql, i)
+			buf.WriteString(sql[i:end])
+			i = end
+
+		case strings.HasPrefix(sql[i:], prefix):
+			start := i + len(prefix)
+			end := start
+			for end < _len && sql[end] >= '0' && sql[end] <= '9' {
+				end++
+			}
+
+			if end == start {
+				buf.WriteString(prefix)
+			} else {
+				n, _ := strconv.Atoi(sql[start:end])
+				index := startIndex + n - 1
+				buf.WriteString(dialect.Placeholder(index))
+				next = max(next, index+1)
+			}
+			i = end
+
+		default:
+			buf.WriteByte(sql[i])
+			i++
+		}
+	}
+
+	return buf.String(), next
+}
+
+func countPlaceholders(sql, placeholder string) (n int) {
+	for i, _len := 0, len(sql); i < _len; {
+		switch {
+		case sql[i] == '\'':
+			i = skipQuotedLiteral(sql, i)
+
+		case strings.HasPrefix(sql[i:], placeholder):
+			n++
+			i += len(placeholder)
+
+		default:
+			i++
+		}
+	}
+	return
+}
+
+// skipQuotedLiteral returns the index after the single-quoted string literal
+// or double-quoted identifier starting at the index start, which is quoted
+// by sql[start] and supports the escaped quote by doubling it.
+func skipQuotedLiteral(sql string, start int) int {
+	quote := sql[start]
+	for i, _len := start+1, len(sql); i < _len; i++ {
+		if sql[i] == quote {
+			if i+1 < _len && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+func (d dialect) LockClause(mode LockMode) string {
+	var supported bool
+	switch d.name {
+	case pqDialect:
+		supported = true
+	case mysqlDialect:
+		supported = mode == LockForUpdate || mode == LockForShare
+	case oracleDialect:
+		supported = mode == LockForUpdate
+	case sqlite3Dialect, sqlserverDialect, clickhouseDialect:
+	default:
+		panic(fmt.Errorf("unknown sql dialect '%s'", d.name))
+	}
+
+	if !supported {
+		panic(fmt.Errorf("sqlx: the dialect '%s' does not support the locking clause '%s'", d.name, mode))
+	}
+	return string(mode)
+}
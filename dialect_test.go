@@ -66,3 +66,194 @@ func TestPostgreSQLDialect(t *testing.T) {
 		t.Errorf("expected 'LIMIT 123 OFFSET 456', got '%s'", s)
 	}
 }
+
+func TestSQLServerDialect(t *testing.T) {
+	if s := SQLServer.Placeholder(2); s != "@p2" {
+		t.Errorf("expected '@p2', got '%s'", s)
+	}
+	if s := SQLServer.Quote("time"); s != "[time]" {
+		t.Errorf("expected '[time]', got '%s'", s)
+	}
+	if s := SQLServer.Quote("t.time"); s != "[t].[time]" {
+		t.Errorf("expected '[t].[time]', got '%s'", s)
+	}
+	if s := SQLServer.Quote("SUM([number])"); s != "SUM([number])" {
+		t.Errorf("expected 'SUM([number])', got '%s'", s)
+	}
+	if d := GetDialect("sqlserver"); d != SQLServer {
+		t.Errorf("expected the registered dialect sqlserver, got %v", d)
+	}
+}
+
+func TestClickHouseDialect(t *testing.T) {
+	if s := ClickHouse.Placeholder(2); s != "?" {
+		t.Errorf("expected '?', got '%s'", s)
+	}
+	if s := ClickHouse.Quote("time"); s != "`time`" {
+		t.Errorf("expected '`time`', got '%s'", s)
+	}
+	if s := ClickHouse.LimitOffset(123, 456); s != "LIMIT 123 OFFSET 456" {
+		t.Errorf("expected 'LIMIT 123 OFFSET 456', got '%s'", s)
+	}
+	if d := GetDialect("clickhouse"); d != ClickHouse {
+		t.Errorf("expected the registered dialect clickhouse, got %v", d)
+	}
+}
+
+func TestOracleDialect(t *testing.T) {
+	if s := Oracle.Placeholder(2); s != ":2" {
+		t.Errorf("expected ':2', got '%s'", s)
+	}
+	if s := Oracle.Quote("t.time"); s != `"t"."time"` {
+		t.Errorf(`expected '"t"."time"', got '%s'`, s)
+	}
+	if d := GetDialect("oracle"); d != Oracle {
+		t.Errorf("expected the registered dialect oracle, got %v", d)
+	}
+}
+
+func TestDialectLimitOffset(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		limit   int64
+		offset  int64
+		expect  string
+	}{
+		{MySQL, 0, 0, ""},
+		{MySQL, 10, 0, "LIMIT 10"},
+		{MySQL, 10, 20, "LIMIT 10 OFFSET 20"},
+		{MySQL, 0, 20, "LIMIT 18446744073709551615 OFFSET 20"},
+
+		{Sqlite3, 0, 0, ""},
+		{Sqlite3, 10, 0, "LIMIT 10"},
+		{Sqlite3, 10, 20, "LIMIT 10 OFFSET 20"},
+		{Sqlite3, 0, 20, "LIMIT -1 OFFSET 20"},
+
+		{Postgres, 0, 0, ""},
+		{Postgres, 10, 0, "LIMIT 10"},
+		{Postgres, 10, 20, "LIMIT 10 OFFSET 20"},
+		{Postgres, 0, 20, "OFFSET 20"},
+
+		{SQLServer, 0, 0, ""},
+		{SQLServer, 10, 0, "OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY"},
+		{SQLServer, 10, 20, "OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+		{SQLServer, 0, 20, "OFFSET 20 ROWS"},
+
+		{ClickHouse, 10, 20, "LIMIT 10 OFFSET 20"},
+		{ClickHouse, 0, 20, "LIMIT 18446744073709551615 OFFSET 20"},
+
+		{Oracle, 0, 0, ""},
+		{Oracle, 10, 20, "OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+		{Oracle, 0, 20, "OFFSET 20 ROWS"},
+	}
+
+	for _, test := range tests {
+		if s := test.dialect.LimitOffset(test.limit, test.offset); s != test.expect {
+			t.Errorf("%s: limit=%d, offset=%d: expect '%s', but got '%s'",
+				test.dialect.Name(), test.limit, test.offset, test.expect, s)
+		}
+	}
+
+}
+
+func TestDialectLimitOffsetNegative(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		limit   int64
+		offset  int64
+	}{
+		{MySQL, 10, -1},
+		{MySQL, 0, -1},
+		{MySQL, -1, 0},
+		{MySQL, -1, 20},
+
+		{Postgres, 10, -1},
+		{Postgres, 0, -1},
+		{Postgres, -1, 0},
+		{Postgres, -1, 20},
+
+		{Sqlite3, 10, -1},
+		{Sqlite3, 0, -1},
+		{Sqlite3, -1, 0},
+		{Sqlite3, -1, 20},
+
+		{SQLServer, 10, -1},
+		{ClickHouse, 10, -1},
+		{Oracle, 10, -1},
+	}
+
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: limit=%d, offset=%d: expect a panic, but got nil",
+						test.dialect.Name(), test.limit, test.offset)
+				}
+			}()
+			test.dialect.LimitOffset(test.limit, test.offset)
+		}()
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: limit=%d, offset=%d: expect a panic when building, but got nil",
+						test.dialect.Name(), test.limit, test.offset)
+				}
+			}()
+			Select("*").From("user").OrderByAsc("id").Limit(test.limit).Offset(test.offset).
+				SetDB(&DB{Dialect: test.dialect}).Build()
+		}()
+	}
+}
+
+func TestRenumberPlaceholders(t *testing.T) {
+	sql, next := RenumberPlaceholders(Postgres, `"id"=$1 AND "name"<>'$1' AND ("age">$2 OR "level"=$2)`, 4)
+	if expect := `"id"=$4 AND "name"<>'$1' AND ("age">$5 OR "level"=$5)`; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+	if next != 6 {
+		t.Errorf("expect the next index 6, but got %d", next)
+	}
+
+	sql, next = RenumberPlaceholders(SQLServer, "[id]=@p1 AND [age]>@p2", 3)
+	if expect := "[id]=@p3 AND [age]>@p4"; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+	if next != 5 {
+		t.Errorf("expect the next index 5, but got %d", next)
+	}
+
+	input := "`id`=? AND `name`<>'?' AND `age`>?"
+	sql, next = RenumberPlaceholders(MySQL, input, 3)
+	if sql != input {
+		t.Errorf("expect sql '%s', but got '%s'", input, sql)
+	}
+	if next != 5 {
+		t.Errorf("expect the next index 5, but got %d", next)
+	}
+}
+
+func TestDialectCapabilities(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		expect  Capabilities
+	}{
+		{MySQL, Capabilities{SupportsLateral: true, SupportsRowValueComparison: true}},
+		{Sqlite3, Capabilities{SupportsRowValueComparison: true, SupportsNullsOrder: true}},
+		{Postgres, Capabilities{
+			SupportsReturning:          true,
+			SupportsDistinctOn:         true,
+			SupportsArrays:             true,
+			SupportsLateral:            true,
+			SupportsWithTies:           true,
+			SupportsRowValueComparison: true,
+			SupportsNullsOrder:         true,
+		}},
+	}
+
+	for _, test := range tests {
+		if caps := test.dialect.Capabilities(); caps != test.expect {
+			t.Errorf("%s: expect capabilities %+v, but got %+v", test.dialect.Name(), test.expect, caps)
+		}
+	}
+}
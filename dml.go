@@ -14,7 +14,10 @@
 
 package sqlx
 
-import "bytes"
+import (
+	"bytes"
+	"fmt"
+)
 
 // JoinOn is the join on statement.
 type JoinOn struct {
@@ -61,6 +64,7 @@ func (jt joinTable) Build(buf *bytes.Buffer, dialect Dialect) {
 type sqlTable struct {
 	Table string
 	Alias string
+	Query *SelectBuilder // Only for the derived table of SELECT
 }
 
 func appendTable(tables []sqlTable, table, alias string) []sqlTable {
@@ -76,3 +80,31 @@ func appendTable(tables []sqlTable, table, alias string) []sqlTable {
 	}
 	return append(tables, sqlTable{Table: table, Alias: alias})
 }
+
+func writeColumns(buf *bytes.Buffer, dialect Dialect, columns []string) {
+	for i, column := range columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(dialect.Quote(column))
+	}
+}
+
+// returnedColumns returns the short names of the RETURNING columns,
+// which returns nil if containing "*".
+func returnedColumns(columns []string) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		if column == "*" {
+			return nil
+		}
+		names[i] = extractName(column)
+	}
+	return names
+}
+
+func checkReturning(dialect Dialect, builder string) {
+	if !dialect.Capabilities().SupportsReturning {
+		panic(fmt.Errorf("sqlx.%s: the dialect '%s' does not support RETURNING", builder, dialect.Name()))
+	}
+}
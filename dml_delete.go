@@ -48,6 +48,8 @@ type DeleteBuilder struct {
 	ftables []sqlTable
 	jtables []joinTable
 	wheres  []op.Condition
+
+	returnings []string
 }
 
 // From is equal to b.FromAlias(table, "").
@@ -128,6 +130,59 @@ func (b *DeleteBuilder) Where(andConditions ...op.Condition) *DeleteBuilder {
 	return b
 }
 
+// WhereIf is the same as Where, but only appends the conditions if cond is true.
+func (b *DeleteBuilder) WhereIf(cond bool, andConditions ...op.Condition) *DeleteBuilder {
+	if cond {
+		b.Where(andConditions...)
+	}
+	return b
+}
+
+// WhereIfNotZero appends the condition "column=value" only if value is not ZERO,
+// such as nil, "", 0, false, the zero time, etc.
+func (b *DeleteBuilder) WhereIfNotZero(column string, value any) *DeleteBuilder {
+	if !isZeroValue(value) {
+		b.Where(op.Equal(column, value))
+	}
+	return b
+}
+
+// Returning sets the RETURNING columns, which is not supported by MySQL and SQLite3.
+func (b *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	b.returnings = columns
+	return b
+}
+
+// QueryRows is equal to b.QueryRowsContext(context.Background()).
+func (b *DeleteBuilder) QueryRows() Rows {
+	return b.QueryRowsContext(context.Background())
+}
+
+// QueryRowsContext builds the sql with the RETURNING columns,
+// executes it and returns the returned rows.
+func (b *DeleteBuilder) QueryRowsContext(ctx context.Context) Rows {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRows(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
+// QueryRow is equal to b.QueryRowContext(context.Background()).
+func (b *DeleteBuilder) QueryRow() Row {
+	return b.QueryRowContext(context.Background())
+}
+
+// QueryRowContext builds the sql with the RETURNING columns,
+// executes it and returns the first returned row.
+func (b *DeleteBuilder) QueryRowContext(ctx context.Context) Row {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRow(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
 // Exec builds the sql and executes it by *sql.DB.
 func (b *DeleteBuilder) Exec() (sql.Result, error) {
 	return b.ExecContext(context.Background())
@@ -152,6 +207,14 @@ func (b *DeleteBuilder) String() string {
 	return sql
 }
 
+// BuildWithDialect is the same as Build, but uses the dialect d instead of
+// the one of the db, which does not modify the db of the builder.
+func (b *DeleteBuilder) BuildWithDialect(d Dialect) (sql string, args *ArgsBuilder) {
+	nb := *b
+	nb.db = &DB{Dialect: d}
+	return nb.Build()
+}
+
 // Build builds the DELETE FROM TABLE sql statement.
 func (b *DeleteBuilder) Build() (sql string, args *ArgsBuilder) {
 	if len(b.ftables) == 0 {
@@ -183,6 +246,13 @@ func (b *DeleteBuilder) Build() (sql string, args *ArgsBuilder) {
 	// Where
 	args = buildWheres(buf, args, dialect, b.wheres)
 
+	// Returning
+	if len(b.returnings) > 0 {
+		checkReturning(dialect, "DeleteBuilder")
+		buf.WriteString(" RETURNING ")
+		writeColumns(buf, dialect, b.returnings)
+	}
+
 	// Comment
 	if b.comment != "" {
 		buf.WriteString(" /* ")
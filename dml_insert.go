@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
 
 	"github.com/xgfone/go-op"
 )
@@ -45,6 +47,10 @@ type InsertBuilder struct {
 	comment string
 	columns []string
 	values  [][]any
+
+	returnings []string
+	conflict   *onConflict
+	unnest     bool
 }
 
 // Into sets the table name with "INSERT INTO".
@@ -92,6 +98,14 @@ func (b *InsertBuilder) Values(values ...any) *InsertBuilder {
 	return b
 }
 
+func (b *InsertBuilder) growvalues(n int) {
+	if cap(b.values)-len(b.values) < n {
+		values := make([][]any, len(b.values), len(b.values)+n)
+		copy(values, b.values)
+		b.values = values
+	}
+}
+
 // Ops is the same as Values. But it will set it if the columns are not set.
 func (b *InsertBuilder) Ops(ops ...op.Op) *InsertBuilder {
 	if len(ops) == 0 {
@@ -154,6 +168,93 @@ func (b *InsertBuilder) NamedValues(nvs ...sql.NamedArg) *InsertBuilder {
 	return b
 }
 
+// SetMap is the same as Values, but uses the keys of m sorted
+// in ascending order as the columns if the columns are not set.
+//
+// If the columns have been set, m must contain all of them.
+func (b *InsertBuilder) SetMap(m map[string]any) *InsertBuilder {
+	if len(m) == 0 {
+		return b
+	}
+
+	if len(b.columns) == 0 {
+		columns := make([]string, 0, len(m))
+		for column := range m {
+			columns = append(columns, column)
+		}
+		slices.Sort(columns)
+		b.columns = columns
+	} else if len(b.columns) != len(m) {
+		panic("sqlx.InsertBuilder: the number of the values is not equal to that of columns")
+	}
+
+	values := make([]any, len(b.columns))
+	for i, column := range b.columns {
+		value, ok := m[column]
+		if !ok {
+			panic(fmt.Errorf("sqlx.InsertBuilder: missing the value of the column '%s'", column))
+		}
+		values[i] = value
+	}
+
+	b.values = append(b.values, values)
+	return b
+}
+
+// Returning sets the RETURNING columns, which is not supported by MySQL and SQLite3.
+func (b *InsertBuilder) Returning(columns ...string) *InsertBuilder {
+	b.returnings = columns
+	return b
+}
+
+// ReturningStruct sets the RETURNING columns from the fields of the struct dest,
+// then executes the INSERT statement and scans the returned row into dest.
+//
+// dest must be a pointer to struct.
+func (b *InsertBuilder) ReturningStruct(ctx context.Context, dest any) error {
+	if !IsPointerToStruct(dest) {
+		panic("sqlx.InsertBuilder.ReturningStruct: not a pointer to struct")
+	}
+
+	namers := defaultGetColumnsFromStruct(dest, "")
+	columns := make([]string, len(namers))
+	for i, namer := range namers {
+		columns[i] = namer.Name
+	}
+
+	return b.Returning(columns...).QueryRowContext(ctx).Scan(dest)
+}
+
+// QueryRows is equal to b.QueryRowsContext(context.Background()).
+func (b *InsertBuilder) QueryRows() Rows {
+	return b.QueryRowsContext(context.Background())
+}
+
+// QueryRowsContext builds the sql with the RETURNING columns,
+// executes it and returns the returned rows.
+func (b *InsertBuilder) QueryRowsContext(ctx context.Context) Rows {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRows(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
+// QueryRow is equal to b.QueryRowContext(context.Background()).
+func (b *InsertBuilder) QueryRow() Row {
+	return b.QueryRowContext(context.Background())
+}
+
+// QueryRowContext builds the sql with the RETURNING columns,
+// executes it and returns the first returned row.
+func (b *InsertBuilder) QueryRowContext(ctx context.Context) Row {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRow(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
 // Exec builds the sql and executes it by *sql.DB.
 func (b *InsertBuilder) Exec() (sql.Result, error) {
 	return b.ExecContext(context.Background())
@@ -178,6 +279,14 @@ func (b *InsertBuilder) String() string {
 	return sql
 }
 
+// BuildWithDialect is the same as Build, but uses the dialect d instead of
+// the one of the db, which does not modify the db of the builder.
+func (b *InsertBuilder) BuildWithDialect(d Dialect) (sql string, args *ArgsBuilder) {
+	nb := *b
+	nb.db = &DB{Dialect: d}
+	return nb.Build()
+}
+
 // Build builds the INSERT INTO TABLE sql statement.
 func (b *InsertBuilder) Build() (sql string, args *ArgsBuilder) {
 	var valnum int
@@ -219,11 +328,18 @@ func (b *InsertBuilder) Build() (sql string, args *ArgsBuilder) {
 		buf.WriteByte(')')
 	}
 
-	buf.WriteString(" VALUES ")
-	if vallen == 0 {
+	switch {
+	case b.unnest:
+		args = b.buildUnnest(buf, dialect)
+
+	case vallen == 0:
+		buf.WriteString(" VALUES ")
 		b.addValues(dialect, buf, nil, valnum, nil)
-	} else {
+
+	default:
+		buf.WriteString(" VALUES ")
 		args = GetArgsBuilderFromPool(dialect)
+		args.Grow(vallen * valnum)
 		for i, vs := range b.values {
 			if i > 0 {
 				buf.WriteString(", ")
@@ -232,6 +348,16 @@ func (b *InsertBuilder) Build() (sql string, args *ArgsBuilder) {
 		}
 	}
 
+	if b.conflict != nil {
+		args = b.conflict.Build(buf, args, dialect, b.columns)
+	}
+
+	if len(b.returnings) > 0 {
+		checkReturning(dialect, "InsertBuilder")
+		buf.WriteString(" RETURNING ")
+		writeColumns(buf, dialect, b.returnings)
+	}
+
 	if b.comment != "" {
 		buf.WriteString(" /* ")
 		buf.WriteString(b.comment)
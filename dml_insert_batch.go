@@ -0,0 +1,84 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ExecBatchContext splits the inserted values into the chunks with at most
+// chunkSize rows, and executes each chunk as a separate INSERT statement
+// in order, which is used to avoid exceeding the limit of the placeholders,
+// such as 65535 for MySQL.
+//
+// If the executor of the db supports the transaction, all the chunks
+// are executed in a single transaction, which is rolled back on the first
+// error. If chunkSize is less than or equal to 0, it is equal to ExecContext.
+//
+// For the returned result, RowsAffected is the sum of all the chunks,
+// and LastInsertId is that of the first chunk.
+func (b *InsertBuilder) ExecBatchContext(ctx context.Context, chunkSize int) (sql.Result, error) {
+	_len := len(b.values)
+	if chunkSize <= 0 || _len <= chunkSize {
+		return b.ExecContext(ctx)
+	}
+
+	var result batchResult
+	exec := func(db *DB) error {
+		for start := 0; start < _len; start += chunkSize {
+			chunk := *b
+			chunk.db = db
+			chunk.values = b.values[start:min(start+chunkSize, _len)]
+
+			r, err := chunk.ExecContext(ctx)
+			if err != nil {
+				return err
+			}
+			if err = result.add(r, start == 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var err error
+	db := getDB(b.db)
+	if _, ok := db.Executor.(TxBeginner); ok || db.InTransaction() {
+		err = db.Transaction(ctx, func(tx *Tx) error { return exec(tx.DB) })
+	} else {
+		err = exec(db)
+	}
+	return result, err
+}
+
+type batchResult struct {
+	lastInsertId int64
+	rowsAffected int64
+}
+
+func (r *batchResult) add(result sql.Result, first bool) error {
+	if first {
+		// Ignore the error because some drivers do not support it, such as PostgreSQL.
+		r.lastInsertId, _ = result.LastInsertId()
+	}
+
+	n, err := result.RowsAffected()
+	r.rowsAffected += n
+	return err
+}
+
+func (r batchResult) LastInsertId() (int64, error) { return r.lastInsertId, nil }
+func (r batchResult) RowsAffected() (int64, error) { return r.rowsAffected, nil }
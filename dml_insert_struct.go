@@ -17,6 +17,7 @@ package sqlx
 import (
 	"database/sql"
 	"reflect"
+	"slices"
 	"sync"
 )
 
@@ -26,6 +27,7 @@ import (
 //
 //  1. If the value of the tag is "-", however, the field will be ignored.
 //  2. If the tag value contains "omitempty" or "omitzero", the ZERO field will be ignored.
+//  3. If the tag value contains "immutable", the field is not updated by Oper.Save.
 func (b *InsertBuilder) Struct(s any) *InsertBuilder {
 	value := reflect.ValueOf(s)
 	extract := getFieldExtracter(value.Type(), getInsertedFieldsFromStruct)
@@ -33,6 +35,81 @@ func (b *InsertBuilder) Struct(s any) *InsertBuilder {
 	return b
 }
 
+// ValuesFromStructs is the same as Struct, but extracts the fields of
+// a slice or array of structs as the multi-row values with the same columns.
+//
+// The field with "omitempty" or "omitzero" is ignored only if it is ZERO
+// in all the structs. If the columns have been set, they must be equal to
+// the extracted columns.
+func (b *InsertBuilder) ValuesFromStructs(structs any) *InsertBuilder {
+	values := reflect.ValueOf(structs)
+	if kind := values.Kind(); kind != reflect.Slice && kind != reflect.Array {
+		panic("sqlx.InsertBuilder.ValuesFromStructs: not a slice or array of structs")
+	}
+
+	_len := values.Len()
+	if _len == 0 {
+		return b
+	}
+
+	elems := make([]reflect.Value, _len)
+	for i := range _len {
+		if elems[i] = values.Index(i); elems[i].Kind() == reflect.Pointer {
+			elems[i] = elems[i].Elem()
+		}
+	}
+
+	fields := getInsertedStructFields(values.Type().Elem())
+	fields = slices.DeleteFunc(slices.Clone(fields), func(f structfield) bool {
+		return f.IgnoreZero && !slices.ContainsFunc(elems, func(v reflect.Value) bool {
+			_, ok := f.InsertedValue(v)
+			return ok
+		})
+	})
+
+	columns := make([]string, len(fields))
+	for i := range fields {
+		columns[i] = fields[i].Column
+	}
+
+	if len(b.columns) == 0 {
+		b.columns = columns
+	} else if !slices.Equal(b.columns, columns) {
+		panic("sqlx.InsertBuilder.ValuesFromStructs: the struct columns are not equal to the set columns")
+	}
+
+	b.growvalues(_len)
+	for _, elem := range elems {
+		vs := make([]any, len(fields))
+		for i := range fields {
+			vs[i] = fields[i].FieldValue(elem)
+		}
+		b.values = append(b.values, vs)
+	}
+
+	return b
+}
+
+var insertedfields sync.Map // reflect.Type => []structfield
+
+func getInsertedStructFields(vtype reflect.Type) []structfield {
+	if fields, ok := insertedfields.Load(vtype); ok {
+		return fields.([]structfield)
+	}
+
+	stype := vtype
+	if stype.Kind() == reflect.Pointer {
+		stype = stype.Elem()
+	}
+	if stype.Kind() != reflect.Struct || stype == _timetype {
+		panic("sqlx.InsertBuilder: not a struct or pointer to struct")
+	}
+
+	fields := extractStructFields(make([]structfield, 0, 16), stype)
+	insertedfields.Store(vtype, fields)
+	return fields
+}
+
 func getInsertedFieldsFromStruct(vtype reflect.Type) fieldExtracter {
 	kind := vtype.Kind()
 	if kind == reflect.Pointer {
@@ -85,3 +162,19 @@ func (f *structfield) InsertedValue(value reflect.Value) (reflect.Value, bool) {
 
 	return value, !ignored
 }
+
+// FieldValue returns the interface value of the field, which returns nil
+// instead if the field is a nil pointer.
+func (f *structfield) FieldValue(value reflect.Value) any {
+	for _, index := range f.Indexes {
+		value = value.Field(index)
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	return value.Interface()
+}
@@ -16,6 +16,7 @@ package sqlx
 
 import (
 	"fmt"
+	"testing"
 	"time"
 )
 
@@ -51,3 +52,35 @@ func ExampleInsertBuilder_Struct() {
 	// INSERT INTO `table` (`id`, `DefaultField`, `field`, `ZeroField`) VALUES (?, ?, ?, ?)
 	// [123 v1 v2 v3]
 }
+
+func TestInsertBuilderStructZeroTime(t *testing.T) {
+	type User struct {
+		Name      string     `sql:"name"`
+		CreatedAt time.Time  `sql:"created_at,omitempty"`
+		DeletedAt *time.Time `sql:"deleted_at,omitempty"`
+	}
+
+	zero := time.Time{}.In(time.FixedZone("UTC+8", 8*3600))
+	sql, args := Insert().Into("user").Struct(User{Name: "abc", CreatedAt: zero, DeletedAt: &zero}).Build()
+	args.Release()
+	if expect := "INSERT INTO `user` (`name`) VALUES (?)"; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	defer func(f func(time.Time) bool) { IsZeroTime = f }(IsZeroTime)
+	IsZeroTime = func(t time.Time) bool { return t.Year() <= 1 }
+
+	sentinel := time.Date(1, 1, 1, 0, 0, 0, 0, time.FixedZone("UTC+8", 8*3600))
+	sql, args = Insert().Into("user").Struct(User{Name: "abc", CreatedAt: sentinel, DeletedAt: &sentinel}).Build()
+	args.Release()
+	if expect := "INSERT INTO `user` (`name`) VALUES (?)"; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	now := time.Now()
+	sql, args = Insert().Into("user").Struct(User{Name: "abc", CreatedAt: now, DeletedAt: &now}).Build()
+	args.Release()
+	if expect := "INSERT INTO `user` (`name`, `created_at`, `deleted_at`) VALUES (?, ?, ?)"; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+}
@@ -15,8 +15,17 @@
 package sqlx
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-op"
 )
 
 func ExampleInsertBuilder() {
@@ -86,3 +95,244 @@ func ExampleInsertBuilder_NamedValues() {
 	// INSERT INTO `table` (`column1`, `column2`, `column3`) VALUES (?, ?, ?)
 	// [value1 value2 value3]
 }
+
+func ExampleInsertBuilder_SetMap() {
+	insert := Insert().Into("table").
+		SetMap(map[string]any{"c3": "v3", "c1": "v1", "c2": "v2"}).
+		SetMap(map[string]any{"c2": "v5", "c3": "v6", "c1": "v4"})
+	sql, args := insert.Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// INSERT INTO `table` (`c1`, `c2`, `c3`) VALUES (?, ?, ?), (?, ?, ?)
+	// [v1 v2 v3 v4 v5 v6]
+}
+
+func ExampleInsertBuilder_OnConflict() {
+	pg := &DB{Dialect: Postgres}
+	build := func(db *DB) *InsertBuilder {
+		return db.Insert().Into("stock").Columns("sku", "name", "num").
+			Values("a1", "apple", 10).
+			OnConflict("sku").
+			DoUpdateColumns("name").
+			DoUpdate(op.Add("num", 10))
+	}
+
+	sql1, args1 := build(nil).Build()
+	sql2, args2 := build(pg).Build()
+	sql3, _ := pg.Insert().Into("stock").Columns("sku", "num").Values("a1", 10).
+		OnConflict("sku").DoNothing().Build()
+
+	fmt.Println(sql1)
+	fmt.Println(args1.Args())
+	fmt.Println(sql2)
+	fmt.Println(args2.Args())
+	fmt.Println(sql3)
+
+	// Output:
+	// INSERT INTO `stock` (`sku`, `name`, `num`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`), `num`=`num`+?
+	// [a1 apple 10 10]
+	// INSERT INTO "stock" ("sku", "name", "num") VALUES ($1, $2, $3) ON CONFLICT ("sku") DO UPDATE SET "name"=EXCLUDED."name", "num"="num"+$4
+	// [a1 apple 10 10]
+	// INSERT INTO "stock" ("sku", "num") VALUES ($1, $2) ON CONFLICT ("sku") DO NOTHING
+}
+
+func ExampleInsertBuilder_OnConstraint() {
+	pg := &DB{Dialect: Postgres}
+	sql1, _ := pg.Insert().Into("stock").Columns("sku", "name").Values("a1", "apple").
+		OnConstraint("uniq_stock_sku").DoUpdateColumns("name").Build()
+	sql2, _ := pg.Insert().Into("stock").Columns("sku", "name").Values("a1", "apple").
+		OnConstraint("uniq_stock_sku").DoNothing().Build()
+
+	fmt.Println(sql1)
+	fmt.Println(sql2)
+
+	// Output:
+	// INSERT INTO "stock" ("sku", "name") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "uniq_stock_sku" DO UPDATE SET "name"=EXCLUDED."name"
+	// INSERT INTO "stock" ("sku", "name") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "uniq_stock_sku" DO NOTHING
+}
+
+func TestInsertBuilderUseUnnest(t *testing.T) {
+	build := func(rows int) (string, []any) {
+		insert := (&DB{Dialect: Postgres}).Insert().Into("user").Columns("id", "name", "nickname").UseUnnest()
+		for i := range rows {
+			var nickname any
+			if i%2 == 0 {
+				nickname = fmt.Sprint("nick", i)
+			}
+			insert.Values(int64(i), fmt.Sprint("name", i), nickname)
+		}
+
+		sql, args := insert.Build()
+		return sql, args.Args()
+	}
+
+	expect := `INSERT INTO "user" ("id", "name", "nickname") SELECT * FROM unnest($1::bigint[], $2::text[], $3::text[])`
+	sql3, args := build(3)
+	if sql5, _ := build(5); sql3 != expect || sql5 != expect {
+		t.Errorf("expect sql '%s', but got '%s' and '%s'", expect, sql3, sql5)
+	}
+
+	nick0, nick2 := "nick0", "nick2"
+	if ids, ok := args[0].([]int64); !ok || !slices.Equal(ids, []int64{0, 1, 2}) {
+		t.Errorf("unexpected ids %#v", args[0])
+	}
+	if names, ok := args[1].([]string); !ok || !slices.Equal(names, []string{"name0", "name1", "name2"}) {
+		t.Errorf("unexpected names %#v", args[1])
+	}
+	if nicks, ok := args[2].([]*string); !ok || len(nicks) != 3 ||
+		*nicks[0] != nick0 || nicks[1] != nil || *nicks[2] != nick2 {
+		t.Errorf("unexpected nicknames %#v", args[2])
+	}
+}
+
+func TestInsertBuilderReturningStruct(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	db, tdb := newTestDB(Postgres, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "created_at", "name"},
+			Rows:    [][]driver.Value{{int64(123), now, "abc"}},
+		}, nil
+	})
+
+	type User struct {
+		Id        int64     `sql:"id"`
+		CreatedAt time.Time `sql:"created_at"`
+		Name      string    `sql:"name"`
+	}
+
+	// Call it twice on the same builder to ensure the columns are not duplicated.
+	q := Insert().Into("user").NamedValues(sql.Named("name", "abc")).SetDB(db)
+	for range 2 {
+		var user User
+		if err := q.ReturningStruct(context.Background(), &user); err != nil {
+			t.Fatal(err)
+		}
+
+		expect := User{Id: 123, CreatedAt: now, Name: "abc"}
+		if user != expect {
+			t.Errorf("expect %+v, but got %+v", expect, user)
+		}
+	}
+
+	const query = `INSERT INTO "user" ("name") VALUES ($1) RETURNING "id", "created_at", "name"`
+	if stmts := tdb.Statements(); !slices.Equal([]string{query, query}, stmts) {
+		t.Errorf("expect the statements %q, but got %q", []string{query, query}, stmts)
+	}
+}
+
+func TestInsertBuilderReturning(t *testing.T) {
+	db, tdb := newTestDB(Postgres, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]driver.Value{{int64(123), "abc"}},
+		}, nil
+	})
+
+	var user testUser
+	ok, err := db.Insert().Into("user").Columns("name", "age").Values("abc", 18).
+		Returning("id", "name").QueryRow().Bind(&user)
+	if err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expect the returned row, but got nothing")
+	} else if expect := (testUser{Id: 123, Name: "abc"}); user != expect {
+		t.Errorf("expect %+v, but got %+v", expect, user)
+	}
+
+	const query = `INSERT INTO "user" ("name", "age") VALUES ($1, $2) RETURNING "id", "name"`
+	if stmts := tdb.Statements(); len(stmts) != 1 || stmts[0] != query {
+		t.Errorf("expect the statement '%s', but got %v", query, stmts)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expect a panic for RETURNING on MySQL")
+			}
+		}()
+		Insert().Into("user").Columns("name").Values("abc").Returning("id").Build()
+	}()
+}
+
+func BenchmarkInsertBuilderBuild1000Rows(b *testing.B) {
+	insert := Insert().Into("table").Columns("c1", "c2", "c3")
+	for i := 0; i < 1000; i++ {
+		insert.Values(i, "v2", "v3")
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, args := insert.Build()
+		args.Release()
+	}
+}
+
+func TestInsertBuilderExecBatchContext(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		if strings.HasPrefix(query, "INSERT") {
+			return testresult{InsertId: args[0].(int64), Affected: int64(len(args))}, nil
+		}
+		return testresult{}, nil
+	})
+
+	insert := db.Insert().Into("table").Columns("id")
+	for i := 1; i <= 5; i++ {
+		insert.Values(int64(i))
+	}
+
+	result, err := insert.ExecBatchContext(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n, _ := result.RowsAffected(); n != 5 {
+		t.Errorf("expect 5 affected rows, but got %d", n)
+	}
+	if id, _ := result.LastInsertId(); id != 1 {
+		t.Errorf("expect the last insert id 1, but got %d", id)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"INSERT INTO `table` (`id`) VALUES (?), (?)",
+		"INSERT INTO `table` (`id`) VALUES (?), (?)",
+		"INSERT INTO `table` (`id`) VALUES (?)",
+		"COMMIT",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestInsertBuilderExecBatchContextRollback(t *testing.T) {
+	errfail := errors.New("fail")
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		if strings.HasPrefix(query, "INSERT") && args[0] == int64(3) {
+			return testresult{}, errfail
+		}
+		return testresult{Affected: 1}, nil
+	})
+
+	insert := db.Insert().Into("table").Columns("id")
+	for i := 1; i <= 5; i++ {
+		insert.Values(int64(i))
+	}
+
+	if _, err := insert.ExecBatchContext(context.Background(), 2); !errors.Is(err, errfail) {
+		t.Errorf("expect error '%v', but got '%v'", errfail, err)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"INSERT INTO `table` (`id`) VALUES (?), (?)",
+		"INSERT INTO `table` (`id`) VALUES (?), (?)",
+		"ROLLBACK",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
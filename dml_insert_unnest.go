@@ -0,0 +1,132 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// UseUnnest makes the multi-row values be inserted by the column arrays
+// for PostgreSQL, that's,
+//
+//	INSERT INTO table (a, b) SELECT * FROM unnest($1::bigint[], $2::text[])
+//
+// so that the statement is the same regardless of the number of the rows,
+// which improves the hit rate of the plan cache for the variable batch sizes.
+//
+// Each column is passed as a typed slice, such as []int64 or []string,
+// whose element type is inferred from the non-nil values of the column.
+// If a column contains nil, the element type is the pointer instead.
+// So the driver must support the slice arguments, such as pgx.
+func (b *InsertBuilder) UseUnnest() *InsertBuilder {
+	b.unnest = true
+	return b
+}
+
+func (b *InsertBuilder) buildUnnest(buf *bytes.Buffer, dialect Dialect) *ArgsBuilder {
+	if dialect.Name() != pqDialect {
+		panic(fmt.Errorf("sqlx.InsertBuilder: the dialect '%s' does not support unnest", dialect.Name()))
+	} else if len(b.columns) == 0 || len(b.values) == 0 {
+		panic("sqlx.InsertBuilder: unnest requires the columns and values")
+	}
+
+	args := GetArgsBuilderFromPool(dialect)
+	args.Grow(len(b.columns))
+
+	buf.WriteString(" SELECT * FROM unnest(")
+	for i := range b.columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		array, pgtype := b.unnestColumn(i)
+		buf.WriteString(args.Add(array))
+		buf.WriteString("::")
+		buf.WriteString(pgtype)
+		buf.WriteString("[]")
+	}
+	buf.WriteByte(')')
+	return args
+}
+
+// unnestColumn collects the values of the i-th column into a typed slice.
+func (b *InsertBuilder) unnestColumn(i int) (array any, pgtype string) {
+	var etype reflect.Type
+	var hasnil bool
+	for _, vs := range b.values {
+		if vs[i] == nil {
+			hasnil = true
+		} else if etype == nil {
+			etype = reflect.TypeOf(vs[i])
+		}
+	}
+
+	if etype == nil {
+		panic(fmt.Errorf("sqlx.InsertBuilder: all the values of column '%s' are nil", b.columns[i]))
+	}
+	pgtype = getUnnestType(b.columns[i], etype)
+
+	stype := etype
+	if hasnil {
+		stype = reflect.PointerTo(etype)
+	}
+
+	values := reflect.MakeSlice(reflect.SliceOf(stype), len(b.values), len(b.values))
+	for j, vs := range b.values {
+		switch v := reflect.ValueOf(vs[i]); {
+		case !v.IsValid():
+		case v.Type() != etype:
+			panic(fmt.Errorf("sqlx.InsertBuilder: the values of column '%s' have the different types", b.columns[i]))
+		case hasnil:
+			p := reflect.New(etype)
+			p.Elem().Set(v)
+			values.Index(j).Set(p)
+		default:
+			values.Index(j).Set(v)
+		}
+	}
+
+	return values.Interface(), pgtype
+}
+
+func getUnnestType(column string, vtype reflect.Type) string {
+	switch {
+	case vtype == _timetype:
+		return "timestamptz"
+	case vtype == _bytestype:
+		return "bytea"
+	}
+
+	switch vtype.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int8, reflect.Int16, reflect.Uint8:
+		return "smallint"
+	case reflect.Int32, reflect.Uint16:
+		return "integer"
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return "bigint"
+	case reflect.Float32:
+		return "real"
+	case reflect.Float64:
+		return "double precision"
+	case reflect.String:
+		return "text"
+	default:
+		panic(fmt.Errorf("sqlx.InsertBuilder: unsupported unnest type '%s' of column '%s'", vtype, column))
+	}
+}
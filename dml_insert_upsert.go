@@ -0,0 +1,190 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+type onConflict struct {
+	columns    []string     // The conflict target columns
+	constraint string       // The conflict target constraint
+	updates    []string     // The columns updated by the inserted values
+	setters    []op.Updater // The setters to update the conflicted record
+}
+
+func (b *InsertBuilder) getConflict() *onConflict {
+	if b.conflict == nil {
+		b.conflict = new(onConflict)
+	}
+	return b.conflict
+}
+
+// OnConflict sets the conflict columns to upsert the records,
+// which is rendered as "ON CONFLICT (columns...)" for PostgreSQL and SQLite3,
+// but ignored for MySQL, which uses "ON DUPLICATE KEY UPDATE" instead.
+//
+// If no columns are updated, the conflicted record will be kept as it is.
+func (b *InsertBuilder) OnConflict(columns ...string) *InsertBuilder {
+	c := b.getConflict()
+	c.columns, c.constraint = columns, ""
+	return b
+}
+
+// OnConstraint is the alternative of OnConflict, which sets the conflict
+// target to the named unique constraint, that's, "ON CONFLICT ON CONSTRAINT name".
+//
+// It is only supported by PostgreSQL but ignored for MySQL.
+func (b *InsertBuilder) OnConstraint(name string) *InsertBuilder {
+	if name == "" {
+		panic("sqlx.InsertBuilder.OnConstraint: the constraint name must not be empty")
+	}
+
+	c := b.getConflict()
+	c.columns, c.constraint = nil, name
+	return b
+}
+
+// DoUpdateColumns appends the columns updated by the inserted values
+// when the record conflicts, that's, "column=EXCLUDED.column" for PostgreSQL
+// and SQLite3 or "column=VALUES(column)" for MySQL.
+func (b *InsertBuilder) DoUpdateColumns(columns ...string) *InsertBuilder {
+	c := b.getConflict()
+	c.updates = append(c.updates, columns...)
+	return b
+}
+
+// DoUpdate appends the setters to update the conflicted record,
+// the arguments of which are appended after those of VALUES.
+func (b *InsertBuilder) DoUpdate(setters ...op.Updater) *InsertBuilder {
+	c := b.getConflict()
+	c.setters = append(c.setters, setters...)
+	return b
+}
+
+// DoNothing keeps the conflicted record as it is, which discards
+// the updated columns and setters set by DoUpdateColumns and DoUpdate.
+//
+// For MySQL, it is rendered as "ON DUPLICATE KEY UPDATE column=column"
+// with the first inserted column.
+func (b *InsertBuilder) DoNothing() *InsertBuilder {
+	c := b.getConflict()
+	c.updates, c.setters = nil, nil
+	return b
+}
+
+func (c *onConflict) hasUpdates() bool {
+	return len(c.updates) > 0 || len(c.setters) > 0
+}
+
+func (c *onConflict) Build(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect, inserted []string) *ArgsBuilder {
+	switch dialect.Name() {
+	case mysqlDialect:
+		return c.buildMySQL(buf, args, dialect, inserted)
+
+	case pqDialect, sqlite3Dialect:
+		if c.constraint != "" && dialect.Name() != pqDialect {
+			panic(fmt.Errorf("sqlx.InsertBuilder: the dialect '%s' does not support ON CONFLICT ON CONSTRAINT", dialect.Name()))
+		}
+		return c.buildPostgres(buf, args, dialect)
+
+	default:
+		panic(fmt.Errorf("sqlx.InsertBuilder: the dialect '%s' does not support upsert", dialect.Name()))
+	}
+}
+
+func (c *onConflict) buildMySQL(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect, inserted []string) *ArgsBuilder {
+	buf.WriteString(" ON DUPLICATE KEY UPDATE ")
+	if !c.hasUpdates() {
+		// MySQL has no DO NOTHING, so update a column to itself instead.
+		if len(inserted) == 0 {
+			panic("sqlx.InsertBuilder: no inserted columns to keep the conflicted record")
+		}
+
+		column := dialect.Quote(inserted[0])
+		buf.WriteString(column)
+		buf.WriteByte('=')
+		buf.WriteString(column)
+		return args
+	}
+
+	for i, column := range c.updates {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		column = dialect.Quote(column)
+		buf.WriteString(column)
+		buf.WriteString("=VALUES(")
+		buf.WriteString(column)
+		buf.WriteByte(')')
+	}
+
+	return c.buildSetters(buf, args, dialect)
+}
+
+func (c *onConflict) buildPostgres(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
+	updated := c.hasUpdates()
+
+	buf.WriteString(" ON CONFLICT")
+	if c.constraint != "" {
+		buf.WriteString(" ON CONSTRAINT ")
+		buf.WriteString(dialect.Quote(c.constraint))
+	} else if len(c.columns) > 0 {
+		buf.WriteString(" (")
+		writeColumns(buf, dialect, c.columns)
+		buf.WriteByte(')')
+	} else if updated {
+		panic("sqlx.InsertBuilder: ON CONFLICT DO UPDATE requires the conflict columns or constraint")
+	}
+
+	if !updated {
+		buf.WriteString(" DO NOTHING")
+		return args
+	}
+
+	buf.WriteString(" DO UPDATE SET ")
+	for i, column := range c.updates {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		column = dialect.Quote(column)
+		buf.WriteString(column)
+		buf.WriteString("=EXCLUDED.")
+		buf.WriteString(column)
+	}
+
+	return c.buildSetters(buf, args, dialect)
+}
+
+func (c *onConflict) buildSetters(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
+	if len(c.setters) == 0 {
+		return args
+	}
+
+	if args == nil {
+		args = GetArgsBuilderFromPool(dialect)
+	}
+
+	if len(c.updates) > 0 {
+		buf.WriteString(", ")
+	}
+	buf.WriteString(BuildOper(args, op.Batch(c.setters...)))
+	return args
+}
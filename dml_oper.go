@@ -16,6 +16,10 @@ package sqlx
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"slices"
 	"time"
 
 	"github.com/xgfone/go-op"
@@ -41,6 +45,7 @@ type Oper[T any] struct {
 	SoftDeleteUpdater func(context.Context) op.Updater
 
 	ignoredcolumns []string
+	batchsize      int
 
 	binder binder
 }
@@ -71,6 +76,24 @@ func (o Oper[T]) WithDB(db *DB) Oper[T] {
 	return o
 }
 
+// Tx returns a new Oper bound to the transaction db, which is used to
+// compose the operations of several Opers in a single transaction. For example,
+//
+//	err := db.Transaction(ctx, func(tx *sqlx.Tx) error {
+//		if err := users.Tx(tx.DB).AddContext(ctx, user); err != nil {
+//			return err
+//		}
+//		return orders.Tx(tx.DB).AddContext(ctx, order)
+//	})
+//
+// If db is not bound to a transaction, it will panic.
+func (o Oper[T]) Tx(db *DB) Oper[T] {
+	if db == nil || !db.InTransaction() {
+		panic("sqlx.Oper.Tx: the db is not bound to a transaction")
+	}
+	return o.WithDB(db)
+}
+
 // WithTable returns a new Oper with the new table.
 func (o Oper[T]) WithTable(table Table) Oper[T] {
 	o.Table = table
@@ -129,6 +152,15 @@ func (o Oper[T]) WithIgnoredColumns(columns []string) Oper[T] {
 	return o
 }
 
+// WithBatchSize returns a new Oper with the batch size, which is the maximum
+// number of the records inserted by a single INSERT statement in BatchAdd.
+//
+// Default: 0, that's, all the records are inserted by one statement.
+func (o Oper[T]) WithBatchSize(size int) Oper[T] {
+	o.batchsize = size
+	return o
+}
+
 // IgnoredColumns returned the ignored selected columns.
 func (o Oper[T]) IgnoredColumns() []string {
 	return o.ignoredcolumns
@@ -161,6 +193,97 @@ func (o Oper[T]) AddContextWithId(ctx context.Context, obj T) (id int64, err err
 	return
 }
 
+// BatchAdd inserts the structs as the records into the sql table
+// by the multi-row INSERT statement, which inserts the same columns as Add.
+//
+// If the batch size set by WithBatchSize is greater than 0, the records
+// are inserted in chunks of at most batch size rows. See ExecBatchContext.
+//
+// If objs is empty, do nothing and return an empty result.
+func (o Oper[T]) BatchAdd(ctx context.Context, objs []T) (sql.Result, error) {
+	if len(objs) == 0 {
+		return batchResult{}, nil
+	}
+
+	return o.Table.InsertInto().ValuesFromStructs(objs).ExecBatchContext(ctx, o.batchsize)
+}
+
+// Save inserts the struct as the record into the sql table,
+// or updates all the inserted columns except conflictColumns
+// and the immutable ones tagged by "immutable", such as created_at,
+// if the record conflicts on conflictColumns.
+func (o Oper[T]) Save(ctx context.Context, obj T, conflictColumns ...string) (err error) {
+	_, err = o.upsert([]T{obj}, conflictColumns).ExecContext(ctx)
+	return
+}
+
+// SaveMany is the same as Save, but inserts or updates the records in batches,
+// each of which is a single multi-row INSERT statement with at most batchSize
+// records. If batchSize is less than or equal to 0, all in one batch.
+//
+// Each batch is executed separately. So use it with Tx in DB.Transaction
+// if all the batches should be atomical.
+func (o Oper[T]) SaveMany(ctx context.Context, objs []T, conflictColumns []string, batchSize int) (err error) {
+	_len := len(objs)
+	if batchSize <= 0 {
+		batchSize = _len
+	}
+
+	for start := 0; start < _len; start += batchSize {
+		end := min(start+batchSize, _len)
+		if _, err = o.upsert(objs[start:end], conflictColumns).ExecContext(ctx); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Upsert inserts the struct as the record into the sql table,
+// or updates updateColumns if the record conflicts on conflictColumns,
+// which is rendered as "ON CONFLICT ... DO UPDATE" for PostgreSQL and SQLite3
+// or "ON DUPLICATE KEY UPDATE" for MySQL.
+//
+// If updateColumns is empty, it is the same as Save, that's, update all
+// the inserted columns except conflictColumns and the immutable ones.
+// Like Save, the ignored selected columns set by WithIgnoredColumns
+// are not applied to the inserted and updated columns.
+func (o Oper[T]) Upsert(ctx context.Context, obj T, conflictColumns []string, updateColumns []string) (err error) {
+	if len(updateColumns) == 0 {
+		return o.Save(ctx, obj, conflictColumns...)
+	}
+
+	_, err = o.Table.InsertInto().ValuesFromStructs([]T{obj}).
+		OnConflict(conflictColumns...).DoUpdateColumns(updateColumns...).
+		ExecContext(ctx)
+	return
+}
+
+func (o Oper[T]) upsert(objs []T, conflictColumns []string) *InsertBuilder {
+	q := o.Table.InsertInto().ValuesFromStructs(objs)
+	updates := o.updatedColumns(q.columns, conflictColumns)
+	return q.OnConflict(conflictColumns...).DoUpdateColumns(updates...)
+}
+
+// updatedColumns returns the inserted columns except conflictColumns
+// and the immutable ones.
+func (o Oper[T]) updatedColumns(columns, conflictColumns []string) []string {
+	fields := getInsertedStructFields(reflect.TypeFor[T]())
+	updates := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if slices.Contains(conflictColumns, column) {
+			continue
+		}
+
+		immutable := slices.ContainsFunc(fields, func(f structfield) bool {
+			return f.Immutable && f.Column == column
+		})
+		if !immutable {
+			updates = append(updates, column)
+		}
+	}
+	return updates
+}
+
 // Update is equal to o.UpdateContext(context.Background(), updater, conds...).
 func (o Oper[T]) Update(updater op.Updater, conds ...op.Condition) error {
 	return o.UpdateContext(context.Background(), updater, conds...)
@@ -178,6 +301,54 @@ func (o Oper[T]) UpdateContext(ctx context.Context, updater op.Updater, conds ..
 	return err
 }
 
+// UpdateAndGet updates the record by the id and queries it again
+// in a single transaction, which is a portable alternative to
+// UPDATE ... RETURNING, for example, for MySQL.
+//
+// If the record does not exist, ok is false.
+func (o Oper[T]) UpdateAndGet(ctx context.Context, id int64, updaters ...op.Updater) (obj T, ok bool, err error) {
+	err = getDB(o.Table.DB).Transaction(ctx, func(tx *Tx) (err error) {
+		oper := o.WithDB(tx.DB)
+		if err = oper.UpdateContext(ctx, op.Batch(updaters...), op.KeyId.Eq(id)); err == nil {
+			obj, ok, err = oper.GetContext(ctx, op.KeyId.Eq(id))
+		}
+		return
+	})
+	return
+}
+
+// FindOrCreate gets the record by findConds, or inserts obj and gets
+// the inserted record by findConds if not found, and reports whether
+// the record is created.
+//
+// The record is inserted with "ON CONFLICT DO NOTHING" for PostgreSQL and SQLite3
+// or "ON DUPLICATE KEY UPDATE" without change for MySQL, so, if the record
+// is inserted by others concurrently, it is got instead without error.
+// So findConds should match the unique key of obj.
+func (o Oper[T]) FindOrCreate(ctx context.Context, findConds []op.Condition, obj T) (record T, created bool, err error) {
+	record, ok, err := o.GetContext(ctx, findConds...)
+	if err != nil || ok {
+		return
+	}
+
+	result, err := o.Table.InsertInto().Struct(obj).DoNothing().ExecContext(ctx)
+	if err != nil {
+		return
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+
+	if record, ok, err = o.GetContext(ctx, findConds...); err == nil && !ok {
+		err = fmt.Errorf("sqlx.Oper.FindOrCreate: not found the record in table '%s' after inserting", o.Table.Name)
+	}
+
+	created = affected > 0
+	return
+}
+
 // Delete is equal to o.DeleteContext(context.Background(), conds...).
 func (o Oper[T]) Delete(conds ...op.Condition) (err error) {
 	return o.DeleteContext(context.Background(), conds...)
@@ -189,6 +360,45 @@ func (o Oper[T]) DeleteContext(ctx context.Context, conds ...op.Condition) error
 	return err
 }
 
+// DeleteReturning deletes the records from table and returns the deleted ones,
+// the columns of which exclude the ignored columns set by WithIgnoredColumns.
+//
+// For PostgreSQL, it executes a single "DELETE ... RETURNING" statement.
+// For others, it selects and then deletes the records in a transaction
+// as the fallback, and the selected records are locked by "FOR UPDATE" for MySQL.
+func (o Oper[T]) DeleteReturning(ctx context.Context, conds ...op.Condition) (objs []T, err error) {
+	var obj T
+	db := o.Table.GetDB()
+	switch name := db.GetDialect().Name(); name {
+	case pqDialect:
+		namers := defaultGetColumnsFromStruct(obj, "")
+		columns := make([]string, 0, len(namers))
+		for _, namer := range namers {
+			if !slices.Contains(o.ignoredcolumns, namer.Name) {
+				columns = append(columns, namer.Name)
+			}
+		}
+
+		err = o.Table.DeleteFrom(conds...).Returning(columns...).
+			QueryRowsContext(ctx).withbinder(o.binder).Bind(&objs)
+
+	default:
+		err = db.Transaction(ctx, func(tx *Tx) (err error) {
+			oper := o.WithDB(tx.DB)
+			q := oper.Select(obj, conds...)
+			if name == mysqlDialect {
+				q.ForUpdate()
+			}
+
+			if err = q.QueryRowsContext(ctx).Bind(&objs); err == nil && len(objs) > 0 {
+				err = oper.DeleteContext(ctx, conds...)
+			}
+			return
+		})
+	}
+	return
+}
+
 // Get is equal to o.GetContext(context.Background(), conds...).
 func (o Oper[T]) Get(conds ...op.Condition) (obj T, ok bool, err error) {
 	return o.GetContext(context.Background(), conds...)
@@ -200,6 +410,22 @@ func (o Oper[T]) GetContext(ctx context.Context, conds ...op.Condition) (obj T,
 	return
 }
 
+// GetForUpdate is the same as GetContext, but locks the queried record
+// by appending the locking clause "FOR UPDATE" to the SELECT statement,
+// which is used to read, modify and write the record.
+//
+// It should only be called with the Oper bound to a transaction by Tx,
+// because the lock is released once the transaction ends. For example,
+//
+//	err := db.Transaction(ctx, func(tx *sqlx.Tx) error {
+//		user, ok, err := users.Tx(tx.DB).GetForUpdate(ctx, op.KeyId.Eq(id))
+//		// ...
+//	})
+func (o Oper[T]) GetForUpdate(ctx context.Context, conds ...op.Condition) (obj T, ok bool, err error) {
+	ok, err = o.Select(obj, conds...).ForUpdate().QueryRowContext(ctx).Bind(&obj)
+	return
+}
+
 // Gets is equal to o.GetsContext(context.Background(), page, conds...).
 func (o Oper[T]) Gets(page op.Pagination, conds ...op.Condition) (objs []T, err error) {
 	return o.GetsContext(context.Background(), page, conds...)
@@ -216,6 +442,50 @@ func (o Oper[T]) GetsContext(ctx context.Context, page op.Pagination, conds ...o
 	return
 }
 
+// GetByIdsOrdered queries the records by the ids with "id IN (...)",
+// and returns them in the order of ids, which works for all the dialects,
+// instead of "ORDER BY FIELD(id, ...)". The missing ids are dropped.
+//
+// The struct T must have a field of the integer type for the column "id".
+func (o Oper[T]) GetByIdsOrdered(ctx context.Context, ids []int64) (objs []T, err error) {
+	if len(ids) == 0 {
+		return
+	}
+
+	fields := getInsertedStructFields(reflect.TypeFor[T]())
+	index := slices.IndexFunc(fields, func(f structfield) bool { return f.Column == op.KeyId.Key })
+	if index < 0 {
+		panic(fmt.Errorf("sqlx.Oper.GetByIdsOrdered: %s has no field for the column '%s'", reflect.TypeFor[T](), op.KeyId.Key))
+	}
+	field := &fields[index]
+
+	var records []T
+	if records, err = o.WithRowsCap(len(ids)).GetsContext(ctx, nil, op.In(op.KeyId.Key, ids)); err != nil {
+		return
+	}
+
+	indexes := make(map[int64]int, len(records))
+	for i := range records {
+		value := reflect.Indirect(reflect.ValueOf(&records[i]).Elem())
+		switch id := reflect.ValueOf(field.FieldValue(value)); id.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			indexes[id.Int()] = i
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			indexes[int64(id.Uint())] = i
+		default:
+			panic(fmt.Errorf("sqlx.Oper.GetByIdsOrdered: unsupported id type %s", id.Type()))
+		}
+	}
+
+	objs = make([]T, 0, len(records))
+	for _, id := range ids {
+		if i, ok := indexes[id]; ok {
+			objs = append(objs, records[i])
+		}
+	}
+	return
+}
+
 // GetRow is equal to o.GetRowContext(context.Background(), columns, conds...).
 func (o Oper[T]) GetRow(columns any, conds ...op.Condition) Row {
 	return o.GetRowContext(context.Background(), columns, conds...)
@@ -0,0 +1,101 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xgfone/go-op"
+)
+
+// Stream queries a set of results from table like GetsContext, but emits
+// each scanned record on the returned channel instead of collecting them
+// into a slice.
+//
+// The record channel is closed when all the records have been emitted
+// or an error occurs, then the error, if any, is sent on the error channel,
+// which is closed after that. So the caller should read the error channel
+// after the record channel is closed, for example,
+//
+//	records, errs := oper.Stream(ctx, nil)
+//	for record := range records {
+//		// ...
+//	}
+//	if err := <-errs; err != nil {
+//		// ...
+//	}
+//
+// If ctx is done, the streaming stops and ctx.Err() is sent as the error.
+// So the caller must cancel ctx when stopping reading the records early,
+// or the streaming goroutine blocks forever. The error channel is buffered,
+// so it is unnecessary to read it when no longer caring about the error.
+func (o Oper[T]) Stream(ctx context.Context, page op.Pagination, conds ...op.Condition) (<-chan T, <-chan error) {
+	records := make(chan T)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(records)
+		err := o.stream(ctx, page, func(obj T) error {
+			select {
+			case records <- obj:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}, conds...)
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return records, errs
+}
+
+// ErrStopStream is used by the callback function of StreamFunc
+// to stop the streaming without the error.
+var ErrStopStream = errors.New("sqlx: stop the stream")
+
+// StreamFunc is the same as Stream, but calls fn with each scanned record
+// in the current goroutine instead of emitting it on a channel, and stops
+// on the first error returned by fn, which is returned.
+//
+// If fn returns ErrStopStream, the streaming stops and returns nil.
+func (o Oper[T]) StreamFunc(ctx context.Context, fn func(T) error, conds ...op.Condition) (err error) {
+	if err = o.stream(ctx, nil, fn, conds...); errors.Is(err, ErrStopStream) {
+		err = nil
+	}
+	return
+}
+
+func (o Oper[T]) stream(ctx context.Context, page op.Pagination, fn func(T) error, conds ...op.Condition) (err error) {
+	var obj T
+	rows := o.GetRowsContext(ctx, obj, page, conds...)
+	if rows.Err != nil {
+		return rows.Err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var obj T
+		if err = rows.Scan(&obj); err != nil {
+			return
+		}
+		if err = fn(obj); err != nil {
+			return
+		}
+	}
+
+	return rows.Rows.Err()
+}
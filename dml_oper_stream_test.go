@@ -0,0 +1,109 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestOperStream(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age"},
+			Rows: [][]driver.Value{
+				{int64(1), "a", int64(10)},
+				{int64(2), "b", int64(20)},
+				{int64(3), "c", int64(30)},
+			},
+		}, nil
+	})
+
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	records, errs := oper.Stream(context.Background(), nil)
+
+	var users []testUser
+	for user := range records {
+		users = append(users, user)
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("expect no error, but got %v", err)
+	}
+
+	expects := []testUser{{Id: 1, Name: "a", Age: 10}, {Id: 2, Name: "b", Age: 20}, {Id: 3, Name: "c", Age: 30}}
+	if !slices.Equal(expects, users) {
+		t.Errorf("expect users %+v, but got %+v", expects, users)
+	}
+
+	expect := "SELECT `id`, `name`, `age` FROM `user` ORDER BY `id` DESC"
+	if stmts := tdb.Statements(); len(stmts) != 1 || stmts[0] != expect {
+		t.Errorf("expect statement %q, but got %q", expect, stmts)
+	}
+}
+
+func TestOperStreamError(t *testing.T) {
+	errfail := errors.New("fail")
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{}, errfail
+	})
+
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	records, errs := oper.Stream(context.Background(), nil)
+	for user := range records {
+		t.Errorf("unexpected user %+v", user)
+	}
+	if err := <-errs; !errors.Is(err, errfail) {
+		t.Errorf("expect error '%v', but got '%v'", errfail, err)
+	}
+	if _, ok := <-errs; ok {
+		t.Errorf("expect the error channel is closed")
+	}
+}
+
+func TestOperStreamFunc(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age"},
+			Rows: [][]driver.Value{
+				{int64(1), "a", int64(10)},
+				{int64(2), "b", int64(20)},
+				{int64(3), "c", int64(30)},
+			},
+		}, nil
+	})
+
+	var names []string
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	err := oper.StreamFunc(context.Background(), func(user testUser) error {
+		if names = append(names, user.Name); len(names) == 2 {
+			return ErrStopStream
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("expect no error, but got %v", err)
+	} else if expects := []string{"a", "b"}; !slices.Equal(expects, names) {
+		t.Errorf("expect names %v, but got %v", expects, names)
+	}
+
+	errfail := errors.New("fail")
+	err = oper.StreamFunc(context.Background(), func(testUser) error { return errfail })
+	if !errors.Is(err, errfail) {
+		t.Errorf("expect error '%v', but got '%v'", errfail, err)
+	}
+}
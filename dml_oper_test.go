@@ -0,0 +1,380 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql/driver"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-op"
+)
+
+type testUser struct {
+	Id   int64  `sql:"id"`
+	Name string `sql:"name"`
+	Age  int    `sql:"age"`
+}
+
+func TestOperSaveMany(t *testing.T) {
+	users := []testUser{
+		{Id: 1, Name: "a", Age: 10},
+		{Id: 2, Name: "b", Age: 20},
+		{Id: 3, Name: "c", Age: 30},
+	}
+
+	tests := []struct {
+		dialect Dialect
+		stmts   []string
+	}{
+		{
+			dialect: MySQL,
+			stmts: []string{
+				"INSERT INTO `user` (`id`, `name`, `age`) VALUES (?, ?, ?), (?, ?, ?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`), `age`=VALUES(`age`)",
+				"INSERT INTO `user` (`id`, `name`, `age`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`), `age`=VALUES(`age`)",
+			},
+		},
+		{
+			dialect: Postgres,
+			stmts: []string{
+				`INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3), ($4, $5, $6) ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name", "age"=EXCLUDED."age"`,
+				`INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name", "age"=EXCLUDED."age"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		db, tdb := newTestDB(test.dialect, nil)
+		oper := NewOperWithTable[testUser](db.NewTable("user"))
+		if err := oper.SaveMany(context.Background(), users, []string{"id"}, 2); err != nil {
+			t.Fatal(err)
+		}
+
+		if stmts := tdb.Statements(); !slices.Equal(test.stmts, stmts) {
+			t.Errorf("%s: expect statements %q, but got %q", test.dialect.Name(), test.stmts, stmts)
+		}
+
+		args := tdb.Args()
+		if len(args) != 2 || len(args[0]) != 6 || len(args[1]) != 3 {
+			t.Errorf("%s: unexpected args %v", test.dialect.Name(), args)
+		} else if expect := []any{int64(3), "c", int64(30)}; !slices.Equal(expect, args[1]) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), expect, args[1])
+		}
+	}
+}
+
+func TestOperBatchAdd(t *testing.T) {
+	users := []testUser{
+		{Id: 1, Name: "a", Age: 10},
+		{Id: 2, Name: "b", Age: 20},
+		{Id: 3, Name: "c", Age: 30},
+	}
+
+	// The ignored columns are only for the selected columns, not inserted ones.
+	db, tdb := newTestDB(Postgres, nil)
+	oper := NewOperWithTable[testUser](db.NewTable("user")).
+		WithIgnoredColumns([]string{"id"}).WithBatchSize(2)
+
+	result, err := oper.BatchAdd(context.Background(), users)
+	if err != nil {
+		t.Fatal(err)
+	} else if n, _ := result.RowsAffected(); n != 0 {
+		t.Errorf("expect %d rows affected, but got %d", 0, n)
+	}
+
+	expects := []string{
+		"BEGIN",
+		`INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3), ($4, $5, $6)`,
+		`INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3)`,
+		"COMMIT",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+
+	if args := tdb.Args(); len(args) != 4 {
+		t.Errorf("unexpected args %v", args)
+	} else if expect := []any{int64(3), "c", int64(30)}; !slices.Equal(expect, args[2]) {
+		t.Errorf("expect args %v, but got %v", expect, args[2])
+	}
+
+	if _, err := oper.BatchAdd(context.Background(), nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOperUpdateAndGet(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		if strings.HasPrefix(query, "SELECT") {
+			return testresult{
+				Columns: []string{"id", "name", "age"},
+				Rows:    [][]driver.Value{{int64(1), "abc", int64(18)}},
+			}, nil
+		}
+		return testresult{Affected: 1}, nil
+	})
+
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	user, ok, err := oper.UpdateAndGet(context.Background(), 1, op.Set("name", "abc"))
+	if err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expect the user, but got nothing")
+	} else if expect := (testUser{Id: 1, Name: "abc", Age: 18}); user != expect {
+		t.Errorf("expect user %+v, but got %+v", expect, user)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"UPDATE `user` SET `name`=? WHERE `id`=?",
+		"SELECT `id`, `name`, `age` FROM `user` WHERE `id`=? ORDER BY `id` DESC LIMIT 1",
+		"COMMIT",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestOperGetForUpdate(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age"},
+			Rows:    [][]driver.Value{{int64(1), "abc", int64(18)}},
+		}, nil
+	})
+
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	err := db.Transaction(context.Background(), func(tx *Tx) error {
+		user, ok, err := oper.Tx(tx.DB).GetForUpdate(context.Background(), op.KeyId.Eq(1))
+		if err == nil && (!ok || user.Name != "abc") {
+			t.Errorf("unexpected user %+v", user)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"SELECT `id`, `name`, `age` FROM `user` WHERE `id`=? ORDER BY `id` DESC LIMIT 1 FOR UPDATE",
+		"COMMIT",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestOperDeleteReturning(t *testing.T) {
+	handler := func(query string, args []any) (testresult, error) {
+		if strings.HasPrefix(query, "SELECT") || strings.Contains(query, "RETURNING") {
+			return testresult{
+				Columns: []string{"id", "name"},
+				Rows:    [][]driver.Value{{int64(1), "a"}, {int64(2), "b"}},
+			}, nil
+		}
+		return testresult{Affected: 2}, nil
+	}
+
+	tests := []struct {
+		dialect Dialect
+		stmts   []string
+	}{
+		{
+			dialect: Postgres,
+			stmts:   []string{`DELETE FROM "user" WHERE "age"<$1 RETURNING "id", "name"`},
+		},
+		{
+			dialect: MySQL,
+			stmts: []string{
+				"BEGIN",
+				"SELECT `id`, `name` FROM `user` WHERE `age`<? ORDER BY `id` DESC FOR UPDATE",
+				"DELETE FROM `user` WHERE `age`<?",
+				"COMMIT",
+			},
+		},
+	}
+
+	expects := []testUser{{Id: 1, Name: "a"}, {Id: 2, Name: "b"}}
+	for _, test := range tests {
+		db, tdb := newTestDB(test.dialect, handler)
+		var binds int
+		oper := NewOperWithTable[testUser](db.NewTable("user")).WithIgnoredColumns([]string{"age"}).
+			WithRowsBinder(RowsBinderFunc(func(scanner RowScanner, dst any) error {
+				binds++
+				return DefaultMixRowsBinder.BindRows(scanner, dst)
+			}))
+
+		users, err := oper.DeleteReturning(context.Background(), op.Less("age", 18))
+		if err != nil {
+			t.Fatal(err)
+		} else if !slices.Equal(expects, users) {
+			t.Errorf("%s: expect users %+v, but got %+v", test.dialect.Name(), expects, users)
+		}
+
+		if binds != 1 {
+			t.Errorf("%s: expect the rows binder to be called once, but got %d", test.dialect.Name(), binds)
+		}
+
+		if stmts := tdb.Statements(); !slices.Equal(test.stmts, stmts) {
+			t.Errorf("%s: expect statements %q, but got %q", test.dialect.Name(), test.stmts, stmts)
+		}
+	}
+}
+
+func TestOperGetByIdsOrdered(t *testing.T) {
+	db, tdb := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age"},
+			Rows: [][]driver.Value{
+				{int64(3), "c", int64(30)},
+				{int64(2), "b", int64(20)},
+				{int64(1), "a", int64(10)},
+			},
+		}, nil
+	})
+
+	oper := NewOperWithTable[testUser](db.NewTable("user"))
+	users, err := oper.GetByIdsOrdered(context.Background(), []int64{2, 4, 1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []testUser{{Id: 2, Name: "b", Age: 20}, {Id: 1, Name: "a", Age: 10}, {Id: 3, Name: "c", Age: 30}}
+	if !slices.Equal(expects, users) {
+		t.Errorf("expect users %+v, but got %+v", expects, users)
+	}
+
+	expect := "SELECT `id`, `name`, `age` FROM `user` WHERE `id` IN (?, ?, ?, ?) ORDER BY `id` DESC"
+	if stmts := tdb.Statements(); len(stmts) != 1 || stmts[0] != expect {
+		t.Errorf("expect statement %q, but got %q", expect, stmts)
+	}
+}
+
+func TestOperSaveImmutable(t *testing.T) {
+	type Product struct {
+		Base1
+		Sku  string `sql:"sku"`
+		Name string `sql:"name"`
+	}
+
+	db, tdb := newTestDB(Postgres, nil)
+	oper := NewOperWithTable[Product](db.NewTable("product"))
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	product := Product{Base1: Base1{Id: 1, CreatedAt: now}, Sku: "a1", Name: "apple"}
+	if err := oper.Save(context.Background(), product, "id"); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{`INSERT INTO "product" ("id", "created_at", "sku", "name") VALUES ($1, $2, $3, $4) ON CONFLICT ("id") DO UPDATE SET "sku"=EXCLUDED."sku", "name"=EXCLUDED."name"`}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestOperUpsert(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		updates []string
+		ignores []string
+		stmt    string
+	}{
+		{
+			dialect: MySQL,
+			stmt:    "INSERT INTO `user` (`id`, `name`, `age`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`), `age`=VALUES(`age`)",
+		},
+		{
+			dialect: Postgres,
+			updates: []string{"age"},
+			stmt:    `INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "age"=EXCLUDED."age"`,
+		},
+		{
+			// The ignored selected columns are not applied, like Save.
+			dialect: Postgres,
+			updates: []string{"age"},
+			ignores: []string{"age"},
+			stmt:    `INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "age"=EXCLUDED."age"`,
+		},
+	}
+
+	for _, test := range tests {
+		db, tdb := newTestDB(test.dialect, nil)
+		oper := NewOperWithTable[testUser](db.NewTable("user")).WithIgnoredColumns(test.ignores)
+		user := testUser{Id: 1, Name: "a", Age: 10}
+		if err := oper.Upsert(context.Background(), user, []string{"id"}, test.updates); err != nil {
+			t.Fatal(err)
+		}
+
+		if stmts := tdb.Statements(); len(stmts) != 1 || stmts[0] != test.stmt {
+			t.Errorf("%s: expect statement %q, but got %q", test.dialect.Name(), test.stmt, stmts)
+		}
+	}
+}
+
+func TestOperFindOrCreate(t *testing.T) {
+	newdb := func(exists bool, affected int64) (Oper[testUser], *testdatabase) {
+		db, tdb := newTestDB(Postgres, func(query string, args []any) (testresult, error) {
+			if strings.HasPrefix(query, "INSERT") {
+				exists = true
+				return testresult{Affected: affected}, nil
+			}
+
+			result := testresult{Columns: []string{"id", "name", "age"}}
+			if exists {
+				result.Rows = [][]driver.Value{{int64(1), "a", int64(10)}}
+			}
+			return result, nil
+		})
+		return NewOperWithTable[testUser](db.NewTable("user")), tdb
+	}
+
+	selectSQL := `SELECT "id", "name", "age" FROM "user" WHERE "name"=$1 ORDER BY "id" DESC LIMIT 1`
+	insertSQL := `INSERT INTO "user" ("id", "name", "age") VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	conds := []op.Condition{op.Equal("name", "a")}
+	expect := testUser{Id: 1, Name: "a", Age: 10}
+
+	tests := []struct {
+		exists   bool
+		affected int64
+		created  bool
+		stmts    []string
+	}{
+		{exists: false, affected: 1, created: true, stmts: []string{selectSQL, insertSQL, selectSQL}},
+		{exists: false, affected: 0, created: false, stmts: []string{selectSQL, insertSQL, selectSQL}}, // Inserted concurrently
+		{exists: true, created: false, stmts: []string{selectSQL}},
+	}
+
+	for i, test := range tests {
+		oper, tdb := newdb(test.exists, test.affected)
+		user, created, err := oper.FindOrCreate(context.Background(), conds, testUser{Name: "a", Age: 10})
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+
+		if created != test.created {
+			t.Errorf("%d: expect created %v, but got %v", i, test.created, created)
+		}
+		if user != expect {
+			t.Errorf("%d: expect %+v, but got %+v", i, expect, user)
+		}
+		if stmts := tdb.Statements(); !slices.Equal(test.stmts, stmts) {
+			t.Errorf("%d: expect statements %q, but got %q", i, test.stmts, stmts)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package sqlx
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"slices"
@@ -75,11 +76,15 @@ func extractName(name string) string {
 type selectedColumn struct {
 	Column string
 	Alias  string
+	Raw    bool // If true, the column is a raw expression not to be quoted.
+	Expr   Expr // If not nil, the column is built by the expression.
 }
 
 type orderby struct {
 	Column string
 	Order  Order
+	Nulls  NullsOrder
+	Raw    bool // If true, the column is a raw expression not to be quoted.
 }
 
 // Order represents the order used by ORDER BY.
@@ -91,22 +96,43 @@ const (
 	Desc Order = "DESC"
 )
 
+// NullsOrder represents the order of NULLs used by ORDER BY.
+type NullsOrder string
+
+// Predefine some orders of NULLs used by ORDER BY.
+const (
+	NullsFirst NullsOrder = "NULLS FIRST"
+	NullsLast  NullsOrder = "NULLS LAST"
+)
+
 // SelectBuilder is used to build the SELECT statement.
 type SelectBuilder struct {
-	db       *DB
-	distinct bool
-	ftables  []sqlTable
-	jtables  []joinTable
-	columns  []selectedColumn
-	wheres   []op.Condition
-	ignores  []string // Ignored the columns
-	havings  []string
-	groupbys []string
-	orderbys []orderby
-	comment  string
-	offset   int64
-	limit    int64
-	page     op.Pagination
+	db         *DB
+	distinct   bool
+	distinctOn []string // The columns of DISTINCT ON
+	ftables    []sqlTable
+	jtables    []joinTable
+	columns    []selectedColumn
+	wheres     []op.Condition
+	ignores    []string // Ignored the columns
+	havings    []string
+	hconds     []op.Condition
+	groupbys   []string
+	rollup     bool
+	orderbys   []orderby
+	comment    string
+	offset     int64
+	limit      int64
+	page       op.Pagination
+	unions     []union
+
+	ctes      []cte
+	recursive bool
+
+	lock rowLock
+
+	quotes     [2]byte // The open and close quote characters to override the dialect
+	simplifyIn bool    // Build the singleton IN and NOT IN as = and <>
 
 	binder binder
 }
@@ -128,17 +154,17 @@ func Sum(field string) string {
 
 // SelectSum appends the selected SUM(field) column in SELECT.
 func (b *SelectBuilder) Sum(field string) *SelectBuilder {
-	return b.Select(Sum(getDB(b.db).GetDialect().Quote(field)))
+	return b.Select(Sum(b.getDialect().Quote(field)))
 }
 
 // SelectCount appends the selected COUNT(field) column in SELECT.
 func (b *SelectBuilder) SelectCount(field string) *SelectBuilder {
-	return b.Select(Count(getDB(b.db).GetDialect().Quote(field)))
+	return b.Select(Count(b.getDialect().Quote(field)))
 }
 
 // SelectCountDistinct appends the selected COUNT(DISTINCT field) column in SELECT.
 func (b *SelectBuilder) SelectCountDistinct(field string) *SelectBuilder {
-	return b.Select(CountDistinct(getDB(b.db).GetDialect().Quote(field)))
+	return b.Select(CountDistinct(b.getDialect().Quote(field)))
 }
 
 // Distinct marks SELECT as DISTINCT.
@@ -147,6 +173,19 @@ func (b *SelectBuilder) Distinct() *SelectBuilder {
 	return b
 }
 
+// DistinctOn marks SELECT as "DISTINCT ON (columns...)", which keeps only
+// the first row of each set of rows where the columns are equal,
+// such as the latest row per group with ORDER BY.
+//
+// It takes precedence over Distinct.
+//
+// Notice: it is only supported by PostgreSQL, and the other dialects
+// will panic when building the statement.
+func (b *SelectBuilder) DistinctOn(columns ...string) *SelectBuilder {
+	b.distinctOn = append(b.distinctOn, columns...)
+	return b
+}
+
 func (b *SelectBuilder) growcolumns(n int) {
 	if cap(b.columns)-len(b.columns) < n {
 		columns := make([]selectedColumn, len(b.columns), len(b.columns)+n)
@@ -165,7 +204,23 @@ func (b *SelectBuilder) Select(column string) *SelectBuilder {
 // If alias is empty, it will be ignored.
 func (b *SelectBuilder) SelectAlias(column, alias string) *SelectBuilder {
 	if column != "" {
-		b.columns = append(b.columns, selectedColumn{column, alias})
+		b.columns = append(b.columns, selectedColumn{Column: column, Alias: alias})
+	}
+	return b
+}
+
+// SelectRaw appends the raw expression as the selected column in SELECT,
+// which is emitted verbatim without quoting, such as "myschema.my_func(id)".
+// But the alias is still quoted.
+//
+// If alias is empty, it will be ignored.
+func (b *SelectBuilder) SelectRaw(expr string, alias ...string) *SelectBuilder {
+	if expr != "" {
+		var _alias string
+		if len(alias) > 0 {
+			_alias = alias[0]
+		}
+		b.columns = append(b.columns, selectedColumn{Column: expr, Alias: _alias, Raw: true})
 	}
 	return b
 }
@@ -242,6 +297,20 @@ func (b *SelectBuilder) FromAlias(table, alias string) *SelectBuilder {
 	return b
 }
 
+// FromSubquery appends the derived table, that's, "(SELECT ...) AS alias".
+//
+// The alias must not be empty, and the comment of sub is ignored.
+func (b *SelectBuilder) FromSubquery(sub *SelectBuilder, alias string) *SelectBuilder {
+	if sub == nil {
+		panic("sqlx.SelectBuilder: the subquery must not be nil")
+	} else if alias == "" {
+		panic("sqlx.SelectBuilder: the alias of the subquery must not be empty")
+	}
+
+	b.ftables = append(b.ftables, sqlTable{Alias: alias, Query: sub})
+	return b
+}
+
 // From is equal to b.FromAlias(table, "").
 func (b *SelectBuilder) From(table string) *SelectBuilder {
 	return b.FromAlias(table, "")
@@ -304,6 +373,23 @@ func (b *SelectBuilder) Where(andConditions ...op.Condition) *SelectBuilder {
 	return b
 }
 
+// WhereIf is the same as Where, but only appends the conditions if cond is true.
+func (b *SelectBuilder) WhereIf(cond bool, andConditions ...op.Condition) *SelectBuilder {
+	if cond {
+		b.Where(andConditions...)
+	}
+	return b
+}
+
+// WhereIfNotZero appends the condition "column=value" only if value is not ZERO,
+// such as nil, "", 0, false, the zero time, etc.
+func (b *SelectBuilder) WhereIfNotZero(column string, value any) *SelectBuilder {
+	if !isZeroValue(value) {
+		b.Where(op.Equal(column, value))
+	}
+	return b
+}
+
 // WhereNamedArgs is the same as Where, but uses the NamedArg as the condition.
 func (b *SelectBuilder) WhereNamedArgs(andArgs ...sql.NamedArg) *SelectBuilder {
 	if b.wheres == nil {
@@ -316,24 +402,83 @@ func (b *SelectBuilder) WhereNamedArgs(andArgs ...sql.NamedArg) *SelectBuilder {
 	return b
 }
 
-// GroupBy resets the GROUP BY columns.
+// GroupBy appends the GROUP BY columns.
 func (b *SelectBuilder) GroupBy(columns ...string) *SelectBuilder {
-	b.groupbys = columns
+	b.groupbys = append(b.groupbys, columns...)
+	return b
+}
+
+// GroupByRollup is the same as GroupBy, but generates the subtotals
+// by ROLLUP, which is built as
+//
+//	MySQL, ClickHouse:           GROUP BY a, b WITH ROLLUP
+//	Postgres, SQLServer, Oracle: GROUP BY ROLLUP(a, b)
+//
+// Notice: SQLite3 does not support ROLLUP, so it will panic when building.
+func (b *SelectBuilder) GroupByRollup(columns ...string) *SelectBuilder {
+	b.rollup = true
+	return b.GroupBy(columns...)
+}
+
+// ResetGroupBy clears the GROUP BY columns, including ROLLUP.
+func (b *SelectBuilder) ResetGroupBy() *SelectBuilder {
+	b.groupbys = nil
+	b.rollup = false
 	return b
 }
 
-// Having appends the HAVING expression.
+// Having appends the HAVING expression, which is written as it is.
+//
+// For the bound arguments, use HavingCond instead.
 func (b *SelectBuilder) Having(exprs ...string) *SelectBuilder {
 	b.havings = append(b.havings, exprs...)
 	return b
 }
 
+// HavingCond appends the HAVING conditions, which are built like WHERE
+// and combined with the expressions of Having by AND, such as
+//
+//	b.HavingCond(op.Greater(Count("*"), 10))
+//
+// The arguments are appended after those of WHERE and before LIMIT.
+func (b *SelectBuilder) HavingCond(andConditions ...op.Condition) *SelectBuilder {
+	b.hconds = append(b.hconds, andConditions...)
+	return b
+}
+
 // OrderBy appends the column used by ORDER BY.
+//
+// column may be the alias of a selected column, such as the alias of
+// an aggregate column in a grouped query.
 func (b *SelectBuilder) OrderBy(column string, order Order) *SelectBuilder {
 	b.orderbys = append(b.orderbys, orderby{Column: column, Order: order})
 	return b
 }
 
+// OrderByNulls is the same as OrderBy, but also sorts the NULLs
+// first or last by nulls, such as "ORDER BY column DESC NULLS LAST".
+//
+// For the dialect not supporting it, such as MySQL, it is emulated by sorting
+// "CASE WHEN column IS NULL THEN 1 ELSE 0 END" first, such as
+//
+//	ORDER BY CASE WHEN `column` IS NULL THEN 1 ELSE 0 END, `column` DESC
+//
+// which uses "THEN 0 ELSE 1" instead for NullsFirst.
+func (b *SelectBuilder) OrderByNulls(column string, order Order, nulls NullsOrder) *SelectBuilder {
+	b.orderbys = append(b.orderbys, orderby{Column: column, Order: order, Nulls: nulls})
+	return b
+}
+
+// OrderByExpr appends the raw expression used by ORDER BY, which is emitted
+// verbatim without quoting, such as "FIELD(status, 1, 2, 3)" or "RAND()",
+// and is not required to appear in the selected columns by Validate.
+func (b *SelectBuilder) OrderByExpr(rawExpr string) *SelectBuilder {
+	if rawExpr != "" {
+		b.orderbys = append(b.orderbys, orderby{Column: rawExpr, Raw: true})
+	}
+	return b
+}
+
 // OrderByDesc appends the column used by ORDER BY DESC.
 func (b *SelectBuilder) OrderByDesc(column string) *SelectBuilder {
 	return b.OrderBy(column, Desc)
@@ -392,12 +537,16 @@ func (b *SelectBuilder) sort(sorter op.Sorter) {
 }
 
 // Limit sets the LIMIT to limit.
+//
+// The negative limit is invalid and panics when building the sql.
 func (b *SelectBuilder) Limit(limit int64) *SelectBuilder {
 	b.limit = limit
 	return b
 }
 
 // Offset sets the OFFSET to offset.
+//
+// The negative offset is invalid and panics when building the sql.
 func (b *SelectBuilder) Offset(offset int64) *SelectBuilder {
 	b.offset = offset
 	return b
@@ -413,6 +562,19 @@ func (b *SelectBuilder) Paginate(pageNum, pageSize int64) *SelectBuilder {
 	return b
 }
 
+// PaginateWithTotal is the same as b.Paginate(pageNum, pageSize), but also
+// selects the total count of the rows ignoring the pagination by the window
+// function as the column totalColumn, that's, "COUNT(*) OVER () AS total",
+// so that the page and the total are queried in a single round trip.
+//
+// Use Rows.BindWithTotal to bind the rows and peel the total off.
+func (b *SelectBuilder) PaginateWithTotal(pageNum, pageSize int64, totalColumn string) *SelectBuilder {
+	if totalColumn == "" {
+		panic("sqlx.SelectBuilder: the total column must not be empty")
+	}
+	return b.SelectRaw("COUNT(*) OVER ()", totalColumn).Paginate(pageNum, pageSize)
+}
+
 // Paginator is deprecated and reserved as the alias of Pagination for backward compatibility.
 func (b *SelectBuilder) Paginator(page op.Pagination) *SelectBuilder {
 	return b.Pagination(page)
@@ -436,6 +598,38 @@ func (b *SelectBuilder) SetDB(db *DB) *SelectBuilder {
 	return b
 }
 
+// WithQuoteChar overrides the quote characters of the identifiers
+// of the dialect only for the current builder, which is an escape hatch
+// for the unusual backends, such as the federated view across databases.
+//
+// Example:
+//
+//	(&DB{Dialect: Postgres}).Select("id").From("user").WithQuoteChar('`', '`')
+//	// => SELECT `id` FROM `user`
+func (b *SelectBuilder) WithQuoteChar(open, close byte) *SelectBuilder {
+	b.quotes = [2]byte{open, close}
+	return b
+}
+
+// SimplifySingletonIn sets whether to build the condition IN and NOT IN
+// with only one value as "column=value" and "column<>value" instead of
+// "column IN (value)" and "column NOT IN (value)", which some query planners
+// optimize better.
+//
+// Default: false
+func (b *SelectBuilder) SimplifySingletonIn(simplify bool) *SelectBuilder {
+	b.simplifyIn = simplify
+	return b
+}
+
+func (b *SelectBuilder) getDialect() Dialect {
+	dialect := getDB(b.db).GetDialect()
+	if b.quotes[0] != 0 {
+		dialect = quoteCharDialect{Dialect: dialect, open: b.quotes[0], close: b.quotes[1]}
+	}
+	return dialect
+}
+
 // String is the same as b.Build(), except args.
 func (b *SelectBuilder) String() string {
 	sql, args := b.Build()
@@ -443,23 +637,79 @@ func (b *SelectBuilder) String() string {
 	return sql
 }
 
+// DebugSQL builds the sql and returns it with the placeholders substituted
+// by the quoted arguments, which is used to diagnose the mismatch between
+// the placeholders and arguments in the logs.
+//
+// Notice: it is only for logging and NOT SAFE to execute.
+func (b *SelectBuilder) DebugSQL() string {
+	sql, args := b.Build()
+	defer args.Release()
+	return DebugSQL(b.getDialect(), sql, args.Args())
+}
+
+// BuildWithDialect is the same as Build, but uses the dialect d instead of
+// the one of the db, which does not modify the db of the builder.
+func (b *SelectBuilder) BuildWithDialect(d Dialect) (sql string, args *ArgsBuilder) {
+	nb := *b
+	nb.db = &DB{Dialect: d}
+	return nb.Build()
+}
+
 // Build builds the SELECT sql statement.
 func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
+	buf := getBuffer()
+	dialect := b.getDialect()
+	if b.simplifyIn {
+		args = GetArgsBuilderFromPool(dialect)
+		args.simplifyIn = true
+	}
+	args = b.build(buf, args, dialect)
+
+	// Comment
+	if b.comment != "" {
+		buf.WriteString(" /* ")
+		buf.WriteString(b.comment)
+		buf.WriteString(" */")
+	}
+
+	sql = buf.String()
+	putBuffer(buf)
+	return
+}
+
+// build writes the statement without the comment into buf,
+// and appends the arguments into args, which will be allocated if nil.
+func (b *SelectBuilder) build(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
+	args = b.buildWith(buf, args, dialect)
+	args = b.buildQuery(buf, args, dialect)
+	args = b.buildUnions(buf, args, dialect)
+	args = b.buildOrderByLimit(buf, args, dialect)
+	b.buildLock(buf, dialect)
+	return args
+}
+
+func (b *SelectBuilder) buildQuery(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
 	if len(b.ftables) == 0 {
 		panic("sqlx.SelectBuilder: no from table names")
 	} else if len(b.columns) == 0 {
 		panic("sqlx.SelectBuilder: no selected columns")
 	}
 
-	buf := getBuffer()
 	buf.WriteString("SELECT ")
 
-	if b.distinct {
+	if len(b.distinctOn) > 0 {
+		if !dialect.Capabilities().SupportsDistinctOn {
+			panic(fmt.Errorf("sqlx.SelectBuilder: the dialect '%s' does not support DISTINCT ON", dialect.Name()))
+		}
+
+		buf.WriteString("DISTINCT ON (")
+		writeColumns(buf, dialect, b.distinctOn)
+		buf.WriteString(") ")
+	} else if b.distinct {
 		buf.WriteString("DISTINCT ")
 	}
 
-	dialect := getDB(b.db).GetDialect()
-
 	// Selected Columns
 	var i int
 	for _, column := range b.columns {
@@ -470,7 +720,16 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 		if i++; i > 1 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(dialect.Quote(column.Column))
+		if column.Expr != nil {
+			if args == nil {
+				args = GetArgsBuilderFromPool(dialect)
+			}
+			buf.WriteString(column.Expr.BuildExpr(args))
+		} else if column.Raw {
+			buf.WriteString(column.Column)
+		} else {
+			buf.WriteString(dialect.Quote(column.Column))
+		}
 		if column.Alias != "" {
 			buf.WriteString(" AS ")
 			buf.WriteString(dialect.Quote(column.Alias))
@@ -483,7 +742,13 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(dialect.Quote(table.Table))
+		if table.Query != nil {
+			buf.WriteByte('(')
+			args = table.Query.build(buf, args, dialect)
+			buf.WriteByte(')')
+		} else {
+			buf.WriteString(dialect.Quote(table.Table))
+		}
 		if table.Alias != "" {
 			buf.WriteString(" AS ")
 			buf.WriteString(dialect.Quote(table.Alias))
@@ -500,15 +765,9 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 
 	// Group By & Having By
 	if len(b.groupbys) > 0 {
-		buf.WriteString(" GROUP BY ")
-		for i, s := range b.groupbys {
-			if i > 0 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(dialect.Quote(s))
-		}
+		b.buildGroupBy(buf, dialect)
 
-		if len(b.havings) > 0 {
+		if len(b.havings) > 0 || len(b.hconds) > 0 {
 			buf.WriteString(" HAVING ")
 			for i, s := range b.havings {
 				if i > 0 {
@@ -516,9 +775,51 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 				}
 				buf.WriteString(s)
 			}
+
+			if len(b.hconds) > 0 {
+				if args == nil {
+					args = GetArgsBuilderFromPool(dialect)
+				}
+				if len(b.havings) > 0 {
+					buf.WriteString(" AND ")
+				}
+				buf.WriteString(BuildOper(args, op.And(b.hconds...)))
+			}
+		}
+	}
+
+	return args
+}
+
+func (b *SelectBuilder) buildGroupBy(buf *bytes.Buffer, dialect Dialect) {
+	var prefix, suffix string
+	if b.rollup {
+		switch name := dialect.Name(); name {
+		case mysqlDialect, clickhouseDialect:
+			suffix = " WITH ROLLUP"
+		case pqDialect, sqlserverDialect, oracleDialect:
+			prefix, suffix = "ROLLUP(", ")"
+		default:
+			panic(fmt.Errorf("sqlx.SelectBuilder: the dialect '%s' does not support ROLLUP", name))
+		}
+	}
+
+	buf.WriteString(" GROUP BY ")
+	buf.WriteString(prefix)
+	for i, s := range b.groupbys {
+		if i > 0 {
+			buf.WriteString(", ")
 		}
+		buf.WriteString(dialect.Quote(s))
 	}
+	buf.WriteString(suffix)
+}
 
+func (b *SelectBuilder) hasOrderByLimit() bool {
+	return len(b.orderbys) > 0 || b.limit != 0 || b.offset != 0 || b.page != nil
+}
+
+func (b *SelectBuilder) buildOrderByLimit(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
 	// Order By
 	if len(b.orderbys) > 0 {
 		buf.WriteString(" ORDER BY ")
@@ -526,18 +827,41 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 			if i > 0 {
 				buf.WriteString(", ")
 			}
-			buf.WriteString(dialect.Quote(ob.Column))
+
+			column := ob.Column
+			if !ob.Raw {
+				column = dialect.Quote(column)
+			}
+
+			nulls := ob.Nulls != "" && dialect.Capabilities().SupportsNullsOrder
+			if ob.Nulls != "" && !nulls {
+				buf.WriteString("CASE WHEN ")
+				buf.WriteString(column)
+				if ob.Nulls == NullsFirst {
+					buf.WriteString(" IS NULL THEN 0 ELSE 1 END, ")
+				} else {
+					buf.WriteString(" IS NULL THEN 1 ELSE 0 END, ")
+				}
+			}
+
+			buf.WriteString(column)
 			if ob.Order != "" {
 				buf.WriteByte(' ')
 				buf.WriteString(string(ob.Order))
 			}
+			if nulls {
+				buf.WriteByte(' ')
+				buf.WriteString(string(ob.Nulls))
+			}
 		}
 	}
 
 	// Limit & Offset
-	if b.limit > 0 || b.offset > 0 {
-		buf.WriteByte(' ')
-		buf.WriteString(dialect.LimitOffset(b.limit, b.offset))
+	if b.limit != 0 || b.offset != 0 {
+		if s := dialect.LimitOffset(b.limit, b.offset); s != "" {
+			buf.WriteByte(' ')
+			buf.WriteString(s)
+		}
 	} else if b.page != nil {
 		if args == nil {
 			args = GetArgsBuilderFromPool(dialect)
@@ -546,14 +870,5 @@ func (b *SelectBuilder) Build() (sql string, args *ArgsBuilder) {
 		buf.WriteString(BuildOper(args, b.page))
 	}
 
-	// Comment
-	if b.comment != "" {
-		buf.WriteString(" /* ")
-		buf.WriteString(b.comment)
-		buf.WriteString(" */")
-	}
-
-	sql = buf.String()
-	putBuffer(buf)
-	return
+	return args
 }
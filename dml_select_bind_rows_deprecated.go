@@ -33,25 +33,6 @@ func (b *SelectBuilder) QueryContext(ctx context.Context) (Rows, error) {
 	return rows, rows.Err
 }
 
-// BindRows is equal to b.BindRowsContext(context.Background(), slice).
-//
-// DEPRECATED!!! Please use b.QueryRows().Bind(slice) instead.
-func (b *SelectBuilder) BindRows(slice any) error {
-	return b.BindRowsContext(context.Background(), slice)
-}
-
-// BindRowsContext is the same QueryContext, but scans the result set
-// into the slice.
-//
-// Notice: slice must be a pointer to a slice. And the element of the slice
-// may be a struct or type implemented the interface sql.Scanner.
-//
-// DEPRECATED!!! Please use b.QueryRowsContext(ctx).Bind(slice) instead.
-func (b *SelectBuilder) BindRowsContext(ctx context.Context, slice any) error {
-	rows, err := b.QueryContext(ctx)
-	return rows.TryBindSlice(slice, err)
-}
-
 // TryBindSlice is the same as BindSlice, which binds rows to slice
 // only if err is equal to nil.
 //
@@ -67,7 +48,7 @@ func (r Rows) TryBindSlice(slice any, err error) error {
 //
 // DEPRECATED!!! Please use r.Bind(slice) instead.
 func (r Rows) BindSlice(slice any) (err error) {
-	defer r.Rows.Close()
+	defer r.Close()
 	return r.ScanSlice(slice)
 }
 
@@ -79,7 +60,7 @@ func (r Rows) ScanStruct(s any) (err error) {
 	if r.Rows == nil {
 		return
 	}
-	return scanStruct(newrowscanner(r, r.Rows.Scan), s)
+	return scanStruct(newrowscanner(r, r.Rows.Scan), s, false)
 }
 
 // ScanStructWithColumns is the same as Scan, but the columns are scanned
@@ -0,0 +1,159 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xgfone/go-op"
+)
+
+type caseWhen struct {
+	cond   any
+	result any
+}
+
+// CaseBuilder is used to build the CASE expression, that's,
+//
+//	CASE WHEN cond THEN result ... ELSE result END
+//	CASE column WHEN value THEN result ... ELSE result END
+//
+// The result of the integer, float or bool type is written literally,
+// nil is written as NULL, and others are added as the arguments.
+type CaseBuilder struct {
+	column     string
+	whens      []caseWhen
+	elseResult any
+	alias      string
+	hasElse    bool
+}
+
+// Case returns a new searched CASE builder, the conditions of which
+// must be op.Condition.
+func Case() *CaseBuilder {
+	return new(CaseBuilder)
+}
+
+// CaseColumn returns a new simple CASE builder, which compares the column
+// with the values of WHEN.
+func CaseColumn(column string) *CaseBuilder {
+	if column == "" {
+		panic("sqlx.CaseColumn: the column must not be empty")
+	}
+	return &CaseBuilder{column: column}
+}
+
+// When appends a "WHEN cond THEN result" branch.
+//
+// For the searched CASE built by Case, cond must be op.Condition.
+// For the simple CASE built by CaseColumn, cond is the compared value.
+func (c *CaseBuilder) When(cond, result any) *CaseBuilder {
+	if _, ok := cond.(op.Condition); c.column == "" && !ok {
+		panic(fmt.Errorf("sqlx.CaseBuilder: the condition must be op.Condition, but got %T", cond))
+	}
+
+	c.whens = append(c.whens, caseWhen{cond: cond, result: result})
+	return c
+}
+
+// Else sets the result of ELSE.
+func (c *CaseBuilder) Else(result any) *CaseBuilder {
+	c.elseResult, c.hasElse = result, true
+	return c
+}
+
+// End sets the alias used by SelectBuilder.SelectExpr if its alias is empty.
+//
+// The alias is ignored if the expression is nested in others, such as FuncExpr.
+func (c *CaseBuilder) End(alias string) *CaseBuilder {
+	c.alias = alias
+	return c
+}
+
+func (c *CaseBuilder) exprAlias() string { return c.alias }
+
+// BuildExpr implements the interface Expr.
+func (c *CaseBuilder) BuildExpr(ab *ArgsBuilder) string {
+	if len(c.whens) == 0 {
+		panic("sqlx.CaseBuilder: no WHEN branches")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CASE")
+	if c.column != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(ab.Quote(c.column))
+	}
+
+	for _, when := range c.whens {
+		sb.WriteString(" WHEN ")
+		if cond, ok := when.cond.(op.Condition); ok && c.column == "" {
+			sb.WriteString(BuildOper(ab, cond))
+		} else {
+			sb.WriteString(ab.Add(when.cond))
+		}
+
+		sb.WriteString(" THEN ")
+		sb.WriteString(caseResult(ab, when.result))
+	}
+
+	if c.hasElse {
+		sb.WriteString(" ELSE ")
+		sb.WriteString(caseResult(ab, c.elseResult))
+	}
+
+	sb.WriteString(" END")
+	return sb.String()
+}
+
+func caseResult(ab *ArgsBuilder, result any) string {
+	switch v := result.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ab.Add(result)
+	}
+}
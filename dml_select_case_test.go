@@ -0,0 +1,42 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+func ExampleCase() {
+	db := &DB{Dialect: Postgres}
+	paid := Case().When(op.Equal("status", "paid"), 1).Else(0)
+	sql1, args1 := db.Select("user_id").SelectExpr(FuncExpr("SUM", paid), "paid").
+		From("order").Where(op.Greater("amount", 100)).GroupBy("user_id").Build()
+
+	level := CaseColumn("level").When(1, "low").When(2, "high").Else(nil).End("level_name")
+	sql2, args2 := db.Select("id").SelectExpr(level, "").From("user").Where(op.Equal("active", true)).Build()
+
+	fmt.Println(sql1)
+	fmt.Println(args1.Args())
+	fmt.Println(sql2)
+	fmt.Println(args2.Args())
+
+	// Output:
+	// SELECT "user_id", SUM(CASE WHEN "status"=$1 THEN 1 ELSE 0 END) AS "paid" FROM "order" WHERE "amount">$2 GROUP BY "user_id"
+	// [paid 100]
+	// SELECT "id", CASE "level" WHEN $1 THEN $2 WHEN $3 THEN $4 ELSE NULL END AS "level_name" FROM "user" WHERE "active"=$5
+	// [1 low 2 high true]
+}
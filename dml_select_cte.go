@@ -0,0 +1,70 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "bytes"
+
+type cte struct {
+	name  string
+	query *SelectBuilder
+}
+
+// With appends a common table expression, that's, "WITH name AS (SELECT ...)",
+// which is prepended to the statement and whose arguments come first.
+//
+// The name is quoted by the dialect, and the comment of sub is ignored.
+func (b *SelectBuilder) With(name string, sub *SelectBuilder) *SelectBuilder {
+	if name == "" {
+		panic("sqlx.SelectBuilder: the name of the common table expression must not be empty")
+	} else if sub == nil {
+		panic("sqlx.SelectBuilder: the common table expression must not be nil")
+	}
+
+	b.ctes = append(b.ctes, cte{name: name, query: sub})
+	return b
+}
+
+// WithRecursive is the same as With, but uses "WITH RECURSIVE" instead
+// so that the common table expression can refer to itself.
+func (b *SelectBuilder) WithRecursive(name string, sub *SelectBuilder) *SelectBuilder {
+	b.recursive = true
+	return b.With(name, sub)
+}
+
+func (b *SelectBuilder) buildWith(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
+	if len(b.ctes) == 0 {
+		return args
+	}
+
+	if b.recursive {
+		buf.WriteString("WITH RECURSIVE ")
+	} else {
+		buf.WriteString("WITH ")
+	}
+
+	for i, cte := range b.ctes {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteString(dialect.Quote(cte.name))
+		buf.WriteString(" AS (")
+		args = cte.query.build(buf, args, dialect)
+		buf.WriteByte(')')
+	}
+
+	buf.WriteByte(' ')
+	return args
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"strings"
+
+	"github.com/xgfone/go-op"
+)
+
+// Expr is a sql expression built with the ArgsBuilder,
+// which quotes the columns by the dialect and appends the arguments.
+type Expr interface {
+	BuildExpr(ab *ArgsBuilder) string
+}
+
+// SelectExpr appends the expression as the selected column in SELECT with the alias.
+//
+// If alias is empty, use the alias of the expression if having, such as
+// the one set by CaseBuilder.End. Or, it will be ignored.
+func (b *SelectBuilder) SelectExpr(expr Expr, alias string) *SelectBuilder {
+	if expr != nil {
+		if a, ok := expr.(interface{ exprAlias() string }); ok && alias == "" {
+			alias = a.exprAlias()
+		}
+		b.columns = append(b.columns, selectedColumn{Alias: alias, Expr: expr})
+	}
+	return b
+}
+
+// SelectCoalesce appends the selected column "COALESCE(column, ?) AS alias",
+// which binds the default value def as the argument for the NULL column.
+//
+// If alias is empty, use the short name of the column instead.
+func (b *SelectBuilder) SelectCoalesce(column string, def any, alias string) *SelectBuilder {
+	if alias == "" {
+		alias = extractName(column)
+	}
+	return b.SelectExpr(coalesceExpr{column: column, def: def}, alias)
+}
+
+type coalesceExpr struct {
+	column string
+	def    any
+}
+
+func (e coalesceExpr) BuildExpr(ab *ArgsBuilder) string {
+	return strings.Join([]string{"COALESCE(", ab.Quote(e.column), ", ", ab.Add(e.def), ")"}, "")
+}
+
+// SelectCountIf appends the selected column that counts the rows matching
+// the condition, which is rendered as "COUNT(*) FILTER (WHERE cond)" for
+// PostgreSQL, or "SUM(CASE WHEN cond THEN 1 ELSE 0 END)" for others.
+func (b *SelectBuilder) SelectCountIf(cond op.Condition, alias string) *SelectBuilder {
+	if cond == nil {
+		panic("sqlx.SelectBuilder.SelectCountIf: the condition must not be nil")
+	}
+	return b.SelectExpr(aggregateIfExpr{cond: cond}, alias)
+}
+
+// SelectSumIf appends the selected column that sums expr of the rows matching
+// the condition, which is rendered as "SUM(expr) FILTER (WHERE cond)" for
+// PostgreSQL, or "SUM(CASE WHEN cond THEN expr ELSE 0 END)" for others.
+func (b *SelectBuilder) SelectSumIf(expr string, cond op.Condition, alias string) *SelectBuilder {
+	if cond == nil {
+		panic("sqlx.SelectBuilder.SelectSumIf: the condition must not be nil")
+	}
+	return b.SelectExpr(aggregateIfExpr{expr: expr, cond: cond}, alias)
+}
+
+// aggregateIfExpr is the conditional aggregate. If expr is empty, count the rows.
+type aggregateIfExpr struct {
+	expr string
+	cond op.Condition
+}
+
+func (e aggregateIfExpr) BuildExpr(ab *ArgsBuilder) string {
+	if ab.Name() == pqDialect {
+		fn := "COUNT(*)"
+		if e.expr != "" {
+			fn = "SUM(" + ab.Quote(e.expr) + ")"
+		}
+		return strings.Join([]string{fn, " FILTER (WHERE ", BuildOper(ab, e.cond), ")"}, "")
+	}
+
+	value := "1"
+	if e.expr != "" {
+		value = ab.Quote(e.expr)
+	}
+	return strings.Join([]string{"SUM(CASE WHEN ", BuildOper(ab, e.cond), " THEN ", value, " ELSE 0 END)"}, "")
+}
+
+// FuncExpr returns an expression that calls the sql function
+// with the expression as the argument, such as "SUM(expr)".
+func FuncExpr(name string, expr Expr) Expr {
+	return funcExpr{name: name, expr: expr}
+}
+
+type funcExpr struct {
+	name string
+	expr Expr
+}
+
+func (f funcExpr) BuildExpr(ab *ArgsBuilder) string {
+	return strings.Join([]string{f.name, "(", f.expr.BuildExpr(ab), ")"}, "")
+}
+
+// WindowBuilder is used to build the window function expression,
+// that's, "expr OVER (PARTITION BY ... ORDER BY ...)".
+type WindowBuilder struct {
+	expr     string
+	parts    []string
+	orderbys []orderby
+}
+
+// Window returns a new window function builder with the function expression,
+// such as "ROW_NUMBER()" or Sum("amount"), which is quoted by the dialect.
+//
+// Example:
+//
+//	Select("id").SelectExpr(Window("ROW_NUMBER()").PartitionBy("uid").OrderByDesc("time"), "rn")
+func Window(expr string) *WindowBuilder {
+	return &WindowBuilder{expr: expr}
+}
+
+// PartitionBy appends the PARTITION BY columns.
+func (w *WindowBuilder) PartitionBy(columns ...string) *WindowBuilder {
+	w.parts = append(w.parts, columns...)
+	return w
+}
+
+// OrderBy appends the ORDER BY column with the order.
+func (w *WindowBuilder) OrderBy(column string, order Order) *WindowBuilder {
+	w.orderbys = append(w.orderbys, orderby{Column: column, Order: order})
+	return w
+}
+
+// OrderByAsc appends the ORDER BY column with ASC.
+func (w *WindowBuilder) OrderByAsc(column string) *WindowBuilder {
+	return w.OrderBy(column, Asc)
+}
+
+// OrderByDesc appends the ORDER BY column with DESC.
+func (w *WindowBuilder) OrderByDesc(column string) *WindowBuilder {
+	return w.OrderBy(column, Desc)
+}
+
+// String is equal to w.Build(DefaultDialect),
+// which can be used by SelectAlias for the default dialect.
+func (w *WindowBuilder) String() string {
+	return w.Build(DefaultDialect)
+}
+
+// Build builds the window function expression with the dialect.
+func (w *WindowBuilder) Build(dialect Dialect) string {
+	var sb strings.Builder
+	sb.WriteString(dialect.Quote(w.expr))
+	sb.WriteString(" OVER (")
+
+	if len(w.parts) > 0 {
+		sb.WriteString("PARTITION BY ")
+		for i, column := range w.parts {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(dialect.Quote(column))
+		}
+	}
+
+	if len(w.orderbys) > 0 {
+		if len(w.parts) > 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString("ORDER BY ")
+		for i, ob := range w.orderbys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(dialect.Quote(ob.Column))
+			if ob.Order != "" {
+				sb.WriteByte(' ')
+				sb.WriteString(string(ob.Order))
+			}
+		}
+	}
+
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// BuildExpr implements the interface Expr.
+func (w *WindowBuilder) BuildExpr(ab *ArgsBuilder) string {
+	return w.Build(ab.Dialect)
+}
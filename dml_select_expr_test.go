@@ -0,0 +1,70 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+func ExampleWindow() {
+	rn := Window("ROW_NUMBER()").PartitionBy("o.user_id").OrderByDesc("o.created_at")
+	total := Window(Sum("amount")).PartitionBy("user_id")
+
+	sql1, _ := Select("id").SelectAlias(rn.String(), "rn").FromAlias("order", "o").Build()
+	sql2, _ := (&DB{Dialect: Postgres}).Select("id").SelectExpr(rn, "rn").SelectExpr(total, "total").
+		FromAlias("order", "o").Build()
+
+	fmt.Println(sql1)
+	fmt.Println(sql2)
+
+	// Output:
+	// SELECT `id`, ROW_NUMBER() OVER (PARTITION BY `o`.`user_id` ORDER BY `o`.`created_at` DESC) AS `rn` FROM `order` AS `o`
+	// SELECT "id", ROW_NUMBER() OVER (PARTITION BY "o"."user_id" ORDER BY "o"."created_at" DESC) AS "rn", SUM("amount") OVER (PARTITION BY "user_id") AS "total" FROM "order" AS "o"
+}
+
+func ExampleSelectBuilder_SelectCoalesce() {
+	sql, args := Select("id").SelectCoalesce("u.score", 0, "").SelectCoalesce("nickname", "-", "name").
+		FromAlias("user", "u").Where(op.Equal("id", 1)).Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT `id`, COALESCE(`u`.`score`, ?) AS `score`, COALESCE(`nickname`, ?) AS `name` FROM `user` AS `u` WHERE `id`=?
+	// [0 - 1]
+}
+
+func ExampleSelectBuilder_SelectCountIf() {
+	build := func(db *DB) {
+		sql, args := db.Select("user_id").
+			SelectCountIf(op.Equal("status", 1), "done").
+			SelectSumIf("amount", op.Greater("amount", 100), "large").
+			From("order").Where(op.Equal("year", 2025)).GroupBy("user_id").Build()
+
+		fmt.Println(sql)
+		fmt.Println(args.Args())
+	}
+
+	build(&DB{Dialect: MySQL})
+	build(&DB{Dialect: Postgres})
+
+	// Output:
+	// SELECT `user_id`, SUM(CASE WHEN `status`=? THEN 1 ELSE 0 END) AS `done`, SUM(CASE WHEN `amount`>? THEN `amount` ELSE 0 END) AS `large` FROM `order` WHERE `year`=? GROUP BY `user_id`
+	// [1 100 2025]
+	// SELECT "user_id", COUNT(*) FILTER (WHERE "status"=$1) AS "done", SUM("amount") FILTER (WHERE "amount">$2) AS "large" FROM "order" WHERE "year"=$3 GROUP BY "user_id"
+	// [1 100 2025]
+}
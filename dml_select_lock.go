@@ -0,0 +1,94 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "bytes"
+
+type rowLock struct {
+	mode   LockMode
+	wait   string // "", "NOWAIT" or "SKIP LOCKED"
+	tables []string
+}
+
+// Lock appends the locking clause of the mode after LIMIT and OFFSET,
+// which is rendered by Dialect.LockClause, so the dialect not supporting
+// the mode will panic when building the statement.
+//
+// LockForNoKeyUpdate and LockForKeyShare are only supported by PostgreSQL.
+func (b *SelectBuilder) Lock(mode LockMode) *SelectBuilder {
+	b.lock.mode = mode
+	return b
+}
+
+// ForUpdate appends the locking clause "FOR UPDATE" after LIMIT and OFFSET
+// to lock the selected rows for the pessimistic locking.
+//
+// Notice: it is only supported by MySQL, PostgreSQL and Oracle,
+// and the other dialects will panic when building the statement.
+func (b *SelectBuilder) ForUpdate() *SelectBuilder {
+	return b.Lock(LockForUpdate)
+}
+
+// ForUpdateOf is the same as ForUpdate, but only locks the rows
+// of the given tables, that's, "FOR UPDATE OF table1, table2".
+func (b *SelectBuilder) ForUpdateOf(tables ...string) *SelectBuilder {
+	b.lock.tables = append(b.lock.tables, tables...)
+	return b.ForUpdate()
+}
+
+// ForShare appends the locking clause "FOR SHARE" after LIMIT and OFFSET.
+//
+// Notice: it is only supported by MySQL and PostgreSQL,
+// and the other dialects will panic when building the statement.
+func (b *SelectBuilder) ForShare() *SelectBuilder {
+	return b.Lock(LockForShare)
+}
+
+// SkipLocked appends the modifier "SKIP LOCKED" to the locking clause,
+// which skips the rows that have been locked by other transactions.
+//
+// It overrides NoWait and only takes effect with the locking clause.
+func (b *SelectBuilder) SkipLocked() *SelectBuilder {
+	b.lock.wait = "SKIP LOCKED"
+	return b
+}
+
+// NoWait appends the modifier "NOWAIT" to the locking clause,
+// which fails immediately if the rows have been locked by other transactions.
+//
+// It overrides SkipLocked and only takes effect with the locking clause.
+func (b *SelectBuilder) NoWait() *SelectBuilder {
+	b.lock.wait = "NOWAIT"
+	return b
+}
+
+func (b *SelectBuilder) buildLock(buf *bytes.Buffer, dialect Dialect) {
+	if b.lock.mode == "" {
+		return
+	}
+
+	buf.WriteByte(' ')
+	buf.WriteString(dialect.LockClause(b.lock.mode))
+
+	if len(b.lock.tables) > 0 {
+		buf.WriteString(" OF ")
+		writeColumns(buf, dialect, b.lock.tables)
+	}
+
+	if b.lock.wait != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(b.lock.wait)
+	}
+}
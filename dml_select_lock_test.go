@@ -0,0 +1,90 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestSelectBuilderLock(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		builder *SelectBuilder
+		sql     string
+	}{
+		{
+			MySQL,
+			Select("id").From("job").Where(op.Equal("status", 0)).Limit(10).ForUpdate().SkipLocked(),
+			"SELECT `id` FROM `job` WHERE `status`=? LIMIT 10 FOR UPDATE SKIP LOCKED",
+		},
+		{
+			MySQL,
+			Select("id").From("job").ForShare().NoWait(),
+			"SELECT `id` FROM `job` FOR SHARE NOWAIT",
+		},
+		{
+			Postgres,
+			Select("j.id").FromAlias("job", "j").ForUpdateOf("j").SkipLocked(),
+			`SELECT "j"."id" FROM "job" AS "j" FOR UPDATE OF "j" SKIP LOCKED`,
+		},
+		{
+			Postgres,
+			Select("id").From("job").Lock(LockForNoKeyUpdate).NoWait(),
+			`SELECT "id" FROM "job" FOR NO KEY UPDATE NOWAIT`,
+		},
+		{
+			Postgres,
+			Select("id").From("job").Lock(LockForKeyShare),
+			`SELECT "id" FROM "job" FOR KEY SHARE`,
+		},
+		{
+			Oracle,
+			Select("id").From("job").ForUpdate().NoWait(),
+			`SELECT "id" FROM "job" FOR UPDATE NOWAIT`,
+		},
+	}
+
+	for _, test := range tests {
+		test.builder.SetDB(&DB{Dialect: test.dialect})
+		if sql := test.builder.String(); sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+	}
+
+	panics := []struct {
+		dialect Dialect
+		mode    LockMode
+	}{
+		{Sqlite3, LockForUpdate},
+		{SQLServer, LockForUpdate},
+		{ClickHouse, LockForUpdate},
+		{Oracle, LockForShare},
+		{MySQL, LockForNoKeyUpdate},
+		{MySQL, LockForKeyShare},
+		{Sqlite3, LockForKeyShare},
+	}
+	for _, p := range panics {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expect a panic for '%s', but got not", p.dialect.Name(), p.mode)
+				}
+			}()
+			_ = Select("id").From("job").Lock(p.mode).SetDB(&DB{Dialect: p.dialect}).String()
+		}()
+	}
+}
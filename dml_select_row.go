@@ -17,6 +17,10 @@ package sqlx
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 )
 
 // QueryRowOne executes the row query sql statement and returns Row instead of *sql.Row.
@@ -26,7 +30,7 @@ func (db *DB) QueryRowOne(query string, args ...any) Row {
 
 // QueryRowOneContext executes the row query sql statement and returns Row instead of *sql.Row.
 func (db *DB) QueryRowOneContext(ctx context.Context, query string, args ...any) Row {
-	return NewRow(db.queryRowsContext(ctx, nil, query, args...))
+	return db.queryRow(ctx, defaultbinder, nil, query, args...)
 }
 
 // QueryRow builds the sql and executes it.
@@ -35,17 +39,62 @@ func (b *SelectBuilder) QueryRow() Row {
 }
 
 // QueryRowContext builds the sql and executes it.
+//
+// It appends "LIMIT 1" to the built sql, but not modifies the builder,
+// unless the limit has been set or the query is an aggregate without GROUP BY.
 func (b *SelectBuilder) QueryRowContext(ctx context.Context) Row {
-	query, args := b.Limit(1).Build()
+	query, args := b.rowbuilder().Build()
 	defer args.Release()
 
 	_args := args.Args()
 	columns := b.SelectedColumns()
-	return b.binder.Row(getDB(b.db).queryRowsContext(ctx, columns, query, _args...))
+	return getDB(b.db).queryRow(ctx, b.binder, columns, query, _args...)
+}
+
+func (b *SelectBuilder) rowbuilder() *SelectBuilder {
+	if b.limit > 0 || (len(b.groupbys) == 0 && len(b.unions) == 0 && b.isAggregate()) {
+		return b
+	}
+
+	nb := *b
+	nb.limit = 1
+	return &nb
+}
+
+// isAggregate reports whether all the selected columns are aggregate functions.
+func (b *SelectBuilder) isAggregate() bool {
+	var n int
+	for _, c := range b.columns {
+		if b.columnIsIgnored(c.Alias) || b.columnIsIgnored(extractName(c.Column)) {
+			continue
+		}
+
+		if !isAggregateColumn(c.Column) {
+			return false
+		}
+		n++
+	}
+	return n > 0
+}
+
+var aggregatefuncs = []string{"COUNT(", "SUM(", "AVG(", "MIN(", "MAX("}
+
+func isAggregateColumn(column string) bool {
+	column = strings.ToUpper(strings.TrimSpace(column))
+	return slices.ContainsFunc(aggregatefuncs, func(prefix string) bool {
+		return strings.HasPrefix(column, prefix)
+	})
 }
 
 /// ---------------------------------------------------------------------- ///
 
+func (db *DB) queryRow(ctx context.Context, binder binder, columns []string, query string, args ...any) Row {
+	rows, columns, cancel, err := db.queryRowsContext(ctx, columns, query, args...)
+	row := binder.Row(rows, columns, err)
+	row.cancel = cancel
+	return row
+}
+
 func (b *binder) Row(rows *sql.Rows, columns []string, err error) Row {
 	if b.wrapper == nil {
 		return Row{rows: rows, err: err, columns: columns, wrapper: defaultbinder.wrapper}
@@ -60,6 +109,7 @@ type Row struct {
 
 	columns []string
 	wrapper RowScannerWrapper
+	cancel  context.CancelFunc
 }
 
 // NewRow returns a new Row.
@@ -67,6 +117,14 @@ func NewRow(rows *sql.Rows, columns []string, err error) Row {
 	return defaultbinder.Row(rows, columns, err)
 }
 
+// close closes the rows and releases the context with the default timeout.
+func (r Row) close() {
+	_ = r.rows.Close()
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
 // Next is the same as sql.Row.Next, but only used to implement RowScanner and must not be called.
 func (r Row) Next() bool { panic("sqlx.Row.Next: cannot be called") }
 
@@ -103,7 +161,7 @@ func (r Row) Scan(dsts ...any) (err error) {
 	if r.err != nil {
 		return r.err
 	}
-	defer r.rows.Close()
+	defer r.close()
 
 	if !r.rows.Next() {
 		if err := r.rows.Err(); err != nil {
@@ -114,3 +172,51 @@ func (r Row) Scan(dsts ...any) (err error) {
 
 	return r.wrapper(newrowscanner(r, r.rows.Scan), dsts...)
 }
+
+// ScanStructSubset is the same as Scan with a pointer to struct s, but only
+// scans the columns named by columns into the fields of s, and discards
+// the other selected columns, so that the other fields are kept as they are.
+//
+// It returns an error if a named column is not selected or has no field in s.
+func (r Row) ScanStructSubset(s any, columns ...string) (err error) {
+	if len(columns) == 0 {
+		panic("sqlx.Row.ScanStructSubset: no columns")
+	} else if !IsPointerToStruct(s) {
+		panic("sqlx.Row.ScanStructSubset: not a pointer to struct")
+	}
+
+	if r.err != nil {
+		return r.err
+	}
+	defer r.close()
+
+	selected, err := r.Columns()
+	if err != nil {
+		return
+	}
+
+	fields := extractScannedStructFields(nil, reflect.TypeOf(s).Elem())
+	for _, column := range columns {
+		if !slices.Contains(selected, column) {
+			return fmt.Errorf("sqlx.Row.ScanStructSubset: the column '%s' is not selected", column)
+		} else if !slices.ContainsFunc(fields, func(f structfield) bool { return f.Column == column }) {
+			return fmt.Errorf("sqlx.Row.ScanStructSubset: the column '%s' has no field in %T", column, s)
+		}
+	}
+
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+
+	// The empty column matches no field, which is discarded.
+	subset := make([]string, len(selected))
+	for i, column := range selected {
+		if slices.Contains(columns, column) {
+			subset[i] = column
+		}
+	}
+	return ScanColumnsToStruct(r.rows.Scan, subset, s)
+}
@@ -16,6 +16,7 @@ package sqlx
 
 import (
 	"database/sql"
+	"encoding/json"
 	"slices"
 	"time"
 )
@@ -77,29 +78,39 @@ func getrowscap(scanner RowScanner, defaultcap int) int {
 	}
 }
 
+// StrictRowScanWrapper is the same as DefaultRowScanWrapper, but returns
+// an error when scanning the row into a struct if a column has no matching
+// field, which is enabled per query by Rows.WithScanner or Row.WithScanner.
+var StrictRowScanWrapper RowScannerWrapper = strictRowScanWrapper
+
 func defaultRowScanWrapper(scanner RowScanner, dsts ...any) error {
-	return scanrow(scanner, dsts...)
+	return scanrow(scanner, false, dsts...)
+}
+
+func strictRowScanWrapper(scanner RowScanner, dsts ...any) error {
+	return scanrow(scanner, true, dsts...)
 }
 
-func scanrow(scanner RowScanner, dsts ...any) (err error) {
+func scanrow(scanner RowScanner, strict bool, dsts ...any) (err error) {
 	if len(dsts) == 1 && IsPointerToStruct(dsts[0]) {
-		return scanStruct(scanner, dsts[0])
+		return scanStruct(scanner, dsts[0], strict)
 	}
 	return scanner.Scan(dsts...)
 }
 
-func scanStruct(scanner RowScanner, dst any) (err error) {
+func scanStruct(scanner RowScanner, dst any, strict bool) (err error) {
 	columns, err := scanner.Columns()
 	if err != nil {
 		return
 	}
-	return ScanColumnsToStruct(scanner.Scan, columns, dst)
+	return scanColumnsToStruct(scanner.Scan, columns, dst, strict)
 }
 
 func needScannerWrapper(v any) bool {
 	switch v.(type) {
 	case *time.Duration, *time.Time, *any,
 		*bool, *float32, *float64, *string,
+		*[]byte, *json.RawMessage,
 		*int, *int8, *int16, *int32, *int64,
 		*uint, *uint8, *uint16, *uint32, *uint64:
 		return true
@@ -15,6 +15,8 @@
 package sqlx
 
 import (
+	"database/sql"
+	"fmt"
 	"reflect"
 
 	"github.com/xgfone/go-toolkit/slicex"
@@ -24,7 +26,29 @@ import (
 // which supports the tag named "sql" to modify the field name.
 //
 // If the value of the tag is "-", however, the field will be ignored.
+//
+// For the field of the struct, slice or map type, which does not implement
+// the interface sql.Scanner, the column value is decoded from JSON.
+// The struct field is matched with the column named by its tag name as a whole,
+// besides its flattened sub-fields.
+//
+// If the tag has the argument "setter=Method", such as `sql:"name,setter=SetName"`,
+// the column value is scanned into a new value of the field type, then passed
+// to the method of the pointer to the struct containing the field, which
+// must have the signature func(FieldType), instead of setting the field.
+//
+// The column without the matching field is discarded.
 func ScanColumnsToStruct(scan func(...any) error, columns []string, s any) (err error) {
+	return scanColumnsToStruct(scan, columns, s, false)
+}
+
+// ScanColumnsToStructStrict is the same as ScanColumnsToStruct, but returns
+// an error instead of discarding the column without the matching field.
+func ScanColumnsToStructStrict(scan func(...any) error, columns []string, s any) (err error) {
+	return scanColumnsToStruct(scan, columns, s, true)
+}
+
+func scanColumnsToStruct(scan func(...any) error, columns []string, s any, strict bool) (err error) {
 	if len(columns) == 0 {
 		panic("sqlx.ScanColumnsToStruct: no selected columns")
 	}
@@ -33,9 +57,23 @@ func ScanColumnsToStruct(scan func(...any) error, columns []string, s any) (err
 	extract := getFieldExtracter(value.Type(), getScannedFieldsFromStruct)
 	values := make([]any, len(columns))
 	extract(value, scannerData{Values: values, Columns: columns})
+
+	if strict {
+		for i, v := range values {
+			if _, ok := v.(discardScanner); ok {
+				return fmt.Errorf("sqlx: no struct field for column %q", columns[i])
+			}
+		}
+	}
+
 	return scan(values...)
 }
 
+// discardScanner is a sql.Scanner to discard the column without the matching field.
+type discardScanner struct{}
+
+func (discardScanner) Scan(any) error { return nil }
+
 type scannerData struct {
 	Columns []string
 	Values  []any
@@ -49,8 +87,13 @@ func getScannedFieldsFromStruct(vtype reflect.Type) fieldExtracter {
 	}
 
 	fields := make([]structfield, 0, 16)
-	fields = extractStructFields(fields, vtype)
+	fields = extractScannedStructFields(fields, vtype)
 	fieldm := slicex.Map(fields, func(f structfield) (string, structfield) { return f.Column, f })
+	for _, field := range fields {
+		if field.Setter != "" {
+			checkFieldSetter(vtype, field)
+		}
+	}
 
 	return func(value reflect.Value, data any) {
 		d := data.(scannerData)
@@ -62,15 +105,88 @@ func getScannedFieldsFromStruct(vtype reflect.Type) fieldExtracter {
 			if field, ok := fieldm[column]; ok {
 				values[i] = field.ScannerValue(value)
 			} else {
-				values[i] = GeneralScanner{}
+				values[i] = discardScanner{}
 			}
 		}
 	}
 }
 
 func (f *structfield) ScannerValue(value reflect.Value) any {
+	if f.Setter != "" {
+		return f.setterScanner(value)
+	}
+
 	for _, index := range f.Indexes {
 		value = value.Field(index)
 	}
+	if f.IsJSON {
+		return jsonScanner{value.Addr().Interface()}
+	}
 	return value.Addr().Interface()
 }
+
+// jsonScanner is a sql.Scanner to decode the JSON column value into Value.
+type jsonScanner struct{ Value any }
+
+func (s jsonScanner) Scan(src any) error { return decodejson(s.Value, src) }
+
+func checkFieldSetter(vtype reflect.Type, field structfield) {
+	last := len(field.Indexes) - 1
+	for _, index := range field.Indexes[:last] {
+		vtype = vtype.Field(index).Type
+	}
+	ftype := vtype.Field(field.Indexes[last]).Type
+
+	method, ok := reflect.PointerTo(vtype).MethodByName(field.Setter)
+	if !ok {
+		panic(fmt.Errorf("sqlx.ScanColumnsToStruct: %s has no the setter method %s", vtype, field.Setter))
+	}
+
+	// The first input argument is the receiver.
+	if mtype := method.Type; mtype.NumIn() != 2 || mtype.NumOut() != 0 || mtype.In(1) != ftype {
+		panic(fmt.Errorf("sqlx.ScanColumnsToStruct: the setter method %s.%s must be func(%s)", vtype, field.Setter, ftype))
+	}
+}
+
+func (f *structfield) setterScanner(value reflect.Value) setterScanner {
+	last := len(f.Indexes) - 1
+	for _, index := range f.Indexes[:last] {
+		value = value.Field(index)
+	}
+
+	return setterScanner{
+		Value:  reflect.New(value.Type().Field(f.Indexes[last]).Type),
+		Setter: value.Addr().MethodByName(f.Setter),
+		IsJSON: f.IsJSON,
+	}
+}
+
+// setterScanner is a sql.Scanner to scan the column value into Value,
+// then pass it to the setter method.
+type setterScanner struct {
+	Value  reflect.Value // The pointer to the new value of the field type
+	Setter reflect.Value
+	IsJSON bool
+}
+
+func (s setterScanner) Scan(src any) (err error) {
+	switch dst := s.Value.Interface(); {
+	case s.IsJSON:
+		err = decodejson(dst, src)
+
+	case needScannerWrapper(dst):
+		err = GeneralScanner{Value: dst}.Scan(src)
+
+	default:
+		if scanner, ok := dst.(sql.Scanner); ok {
+			err = scanner.Scan(src)
+		} else if src != nil {
+			err = fmt.Errorf("converting %T to %T is unsupported", src, dst)
+		}
+	}
+
+	if err == nil {
+		s.Setter.Call([]reflect.Value{s.Value.Elem()})
+	}
+	return
+}
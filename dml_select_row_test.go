@@ -0,0 +1,74 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql/driver"
+	"slices"
+	"testing"
+)
+
+func TestSelectBuilderQueryRowLimit(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+
+	q := db.Select("id").From("user")
+	_, _ = q.QueryRow().Bind(new(int))
+	if q.limit != 0 {
+		t.Errorf("expect the limit 0, but got %d", q.limit)
+	}
+
+	_, _ = db.Select("id").From("user").Limit(10).QueryRow().Bind(new(int))
+	_, _ = db.Select(Count("*")).From("user").QueryRow().Bind(new(int))
+	_, _ = db.Select("area").Select(Count("*")).From("user").GroupBy("area").QueryRow().Bind(new(string), new(int))
+
+	expects := []string{
+		"SELECT `id` FROM `user` LIMIT 1",
+		"SELECT `id` FROM `user` LIMIT 10",
+		"SELECT COUNT(*) FROM `user`",
+		"SELECT `area`, COUNT(*) FROM `user` GROUP BY `area` LIMIT 1",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestRowScanStructSubset(t *testing.T) {
+	type User struct {
+		Id    int64  `sql:"id"`
+		Name  string `sql:"name"`
+		Age   int    `sql:"age"`
+		Email string `sql:"email"`
+		Phone string `sql:"phone"`
+	}
+
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age", "email", "phone"},
+			Rows:    [][]driver.Value{{int64(1), "abc", int64(18), "a@b.c", "123"}},
+		}, nil
+	})
+
+	query := db.SelectStruct(User{}).From("user")
+	user := User{Email: "keep"}
+	if err := query.QueryRow().ScanStructSubset(&user, "id", "name"); err != nil {
+		t.Fatal(err)
+	} else if expect := (User{Id: 1, Name: "abc", Email: "keep"}); user != expect {
+		t.Errorf("expect user %+v, but got %+v", expect, user)
+	}
+
+	if err := query.QueryRow().ScanStructSubset(&user, "id", "unknown"); err == nil {
+		t.Errorf("expect an error for the unknown column, but got nil")
+	}
+}
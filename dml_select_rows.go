@@ -17,6 +17,10 @@ package sqlx
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 )
 
 // QueryRows executes the query sql statement and returns Rows instead of *sql.Rows.
@@ -26,28 +30,54 @@ func (db *DB) QueryRows(query string, args ...any) Rows {
 
 // QueryRowsContext executes the query sql statement and returns Rows instead of *sql.Rows.
 func (db *DB) QueryRowsContext(ctx context.Context, query string, args ...any) Rows {
-	return NewRows(db.queryRowsContext(ctx, nil, query, args...))
+	return db.queryRows(ctx, defaultbinder, nil, query, args...)
 }
 
-func (db *DB) queryRowsContext(ctx context.Context, columns []string, query string, args ...any) (*sql.Rows, []string, error) {
-	query, args, err := db.Intercept(query, args)
-	if err != nil {
-		return nil, nil, err
+// WithRowsObserver returns a copy of db, which calls observe with the query,
+// the number of the scanned rows and the elapsed time from executing the query
+// to finishing binding the rows when calling Rows.Bind.
+func (db *DB) WithRowsObserver(observe func(query string, rows int, dur time.Duration)) *DB {
+	ndb := *getDB(db)
+	ndb.observer = observe
+	return &ndb
+}
+
+func (db *DB) queryRows(ctx context.Context, binder binder, columns []string, query string, args ...any) Rows {
+	start := time.Now()
+	_rows, columns, cancel, err := db.queryRowsContext(ctx, columns, query, args...)
+	rows := binder.Rows(_rows, columns, err)
+	rows.cancel = cancel
+	if db.observer != nil && rows.Err == nil {
+		rows.observer = &rowsObserver{query: query, start: start, observe: db.observer}
 	}
+	return rows
+}
 
-	rows, err := db.QueryContext(ctx, query, args...)
+// queryRowsContext executes the query with the default timeout set by
+// WithQueryTimeout, and returns the cancel function of the context,
+// which must be called after the rows are closed, if no error.
+func (db *DB) queryRowsContext(ctx context.Context, columns []string, query string, args ...any) (
+	rows *sql.Rows, _ []string, cancel context.CancelFunc, err error) {
+	query, args, err = db.Intercept(query, args)
 	if err != nil {
-		return nil, nil, err
+		return
+	}
+
+	ctx, cancel = db.withTimeout(ctx)
+	if rows, err = db.QueryContext(ctx, query, args...); err != nil {
+		cancel()
+		return nil, nil, nil, err
 	}
 
 	if len(columns) == 0 {
 		if columns, err = rows.Columns(); err != nil {
 			_ = rows.Close()
-			return nil, nil, err
+			cancel()
+			return nil, nil, nil, err
 		}
 	}
 
-	return rows, columns, nil
+	return rows, columns, cancel, nil
 }
 
 // QueryRows builds the sql and executes it.
@@ -62,7 +92,21 @@ func (b *SelectBuilder) QueryRowsContext(ctx context.Context) Rows {
 
 	_args := args.Args()
 	columns := b.SelectedColumns()
-	return b.binder.Rows(getDB(b.db).queryRowsContext(ctx, columns, query, _args...))
+	return getDB(b.db).queryRows(ctx, b.binder, columns, query, _args...)
+}
+
+// BindRows is equal to b.BindRowsContext(context.Background(), dst).
+func (b *SelectBuilder) BindRows(dst any) error {
+	return b.BindRowsContext(context.Background(), dst)
+}
+
+// BindRowsContext is a convenient function, which builds the sql, executes it
+// and binds the rows to dst that may be a map or slice.
+//
+// It is equal to b.QueryRowsContext(ctx).Bind(dst), so the rows binder
+// of the builder still applies.
+func (b *SelectBuilder) BindRowsContext(ctx context.Context, dst any) error {
+	return b.QueryRowsContext(ctx).Bind(dst)
 }
 
 /// ---------------------------------------------------------------------- ///
@@ -86,13 +130,30 @@ func (b *binder) Rows(rows *sql.Rows, columns []string, err error) Rows {
 	return Rows{Rows: rows, Err: err, columns: columns, binder: *b}
 }
 
+// withbinder returns a new Rows with the binder b if b is not empty.
+func (r Rows) withbinder(b binder) Rows {
+	if b.rowscap != 0 || b.wrapper != nil || b.binder != nil {
+		r.binder = b
+	}
+	return r
+}
+
 // Rows is the same as sql.Rows to scan the rows to a map or slice.
 type Rows struct {
 	*sql.Rows
 	Err error
 
-	columns []string
-	binder  binder
+	columns  []string
+	binder   binder
+	observer *rowsObserver
+	cancel   context.CancelFunc
+}
+
+type rowsObserver struct {
+	observe func(query string, rows int, dur time.Duration)
+	query   string
+	start   time.Time
+	rows    int
 }
 
 // NewRows returns a new Rows.
@@ -100,6 +161,18 @@ func NewRows(rows *sql.Rows, columns []string, err error) Rows {
 	return defaultbinder.Rows(rows, columns, err)
 }
 
+// Close closes the rows and releases the context with the default timeout
+// set by DB.WithQueryTimeout, which overrides sql.Rows.Close.
+func (r Rows) Close() (err error) {
+	if r.Rows != nil {
+		err = r.Rows.Close()
+	}
+	if r.cancel != nil {
+		r.cancel()
+	}
+	return
+}
+
 // RowsCap returns the capacity of the rows.
 func (r Rows) RowsCap() int {
 	return r.binder.rowscap
@@ -143,12 +216,98 @@ func (r Rows) Bind(dst any) error {
 		return r.Err
 	}
 
-	defer r.Rows.Close()
+	defer r.Close()
+	if r.observer != nil {
+		defer func() { r.observer.observe(r.observer.query, r.observer.rows, time.Since(r.observer.start)) }()
+	}
 	return r.binder.binder.BindRows(r, dst)
 }
 
+// BindWithTotal is the same as Bind, but peels the column totalColumn off
+// each row as the total, which is selected by SelectBuilder.PaginateWithTotal,
+// and binds the rest columns to dst.
+//
+// If no row, the total is 0.
+func (r Rows) BindWithTotal(dst any, totalColumn string) (total int64, err error) {
+	if r.Err != nil {
+		return 0, r.Err
+	}
+
+	columns, err := r.Columns()
+	if err != nil {
+		r.Close()
+		return
+	}
+
+	index := slices.Index(columns, totalColumn)
+	if index < 0 {
+		r.Close()
+		return 0, fmt.Errorf("sqlx: the total column %q is not selected", totalColumn)
+	}
+
+	wrapper := r.binder.wrapper
+	if wrapper == nil {
+		wrapper = DefaultRowScanWrapper
+	}
+
+	r = r.WithColumns(slices.Delete(slices.Clone(columns), index, index+1)...)
+	r = r.WithScanner(func(scanner RowScanner, dsts ...any) error {
+		return wrapper(totalScanner{RowScanner: scanner, index: index, total: &total}, dsts...)
+	})
+	err = r.Bind(dst)
+	return
+}
+
+// totalScanner is a RowScanner to scan the total column at index into total
+// besides the other columns.
+type totalScanner struct {
+	RowScanner
+	index int
+	total *int64
+}
+
+func (s totalScanner) Unwrap() RowScanner { return s.RowScanner }
+func (s totalScanner) Scan(dsts ...any) error {
+	return s.RowScanner.Scan(slices.Insert(slices.Clone(dsts), s.index, any(s.total))...)
+}
+
+// ErrMultipleRows is returned by Rows.BindOne when more than one row is queried.
+var ErrMultipleRows = errors.New("sqlx: more than one row in the result set")
+
+// BindOne binds the first row to dst like Row.Bind, but returns
+// ErrMultipleRows if there is a second row, which is used to enforce
+// that at most one row matches, for example, by the unique key.
+//
+// If no row, return (false, nil).
+func (r Rows) BindOne(dst any) (found bool, err error) {
+	if r.Err != nil {
+		return false, r.Err
+	}
+
+	defer r.Close()
+	if r.observer != nil {
+		defer func() { r.observer.observe(r.observer.query, r.observer.rows, time.Since(r.observer.start)) }()
+	}
+
+	if !r.Next() {
+		return false, r.Rows.Err()
+	}
+
+	if err = r.Scan(dst); err != nil {
+		return
+	}
+
+	if r.Next() {
+		return true, ErrMultipleRows
+	}
+	return true, r.Rows.Err()
+}
+
 // Scan implements the interface sql.Scanner, which is the same as sql.Rows.Scan
 // but supports that the sql value is NULL.
 func (r Rows) Scan(dsts ...any) (err error) {
-	return r.binder.wrapper(newrowscanner(r, r.Rows.Scan), dsts...)
+	if err = r.binder.wrapper(newrowscanner(r, r.Rows.Scan), dsts...); err == nil && r.observer != nil {
+		r.observer.rows++
+	}
+	return
 }
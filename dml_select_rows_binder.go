@@ -42,6 +42,7 @@ var (
 	//   []int, []int64, []string
 	//   map[string]int, map[string]string
 	//   map[string]bool, map[string]struct{}
+	//   []map[string]any
 	DefaultMixRowsBinder = NewMixRowsBinder()
 
 	// CommonSliceRowsBinder is the common rows binder to bind the rows to a slice.
@@ -96,6 +97,9 @@ func init() {
 	DefaultMixRowsBinder.Register(reflect.TypeFor[*[]string](), NewSliceRowsBinder[[]string]())
 	DefaultMixRowsBinder.Register(reflect.TypeFor[*[]time.Time](), NewSliceRowsBinder[[]time.Time]())
 
+	// []map[string]any
+	DefaultMixRowsBinder.Register(reflect.TypeFor[*[]map[string]any](), MapSliceRowsBinder)
+
 	/// ------------------------------------- map[K]bool -------------------------------------- ///
 
 	// map[int]bool
@@ -329,6 +333,57 @@ func NewSliceRowsBinder[S ~[]T, T any]() RowsBinder {
 	})
 }
 
+// MapSliceRowsBinder is the rows binder to bind the rows to *[]map[string]any,
+// each map of which is a row keyed by the column names, which is used
+// for the dynamic columns without the struct.
+//
+// The column value of []byte is converted to string like GeneralScanner,
+// and NULL is kept as nil.
+var MapSliceRowsBinder RowsBinder = RowsBinderFunc(bindMapSliceRows)
+
+func bindMapSliceRows(scanner RowScanner, dst any) (err error) {
+	dstps, ok := dst.(*[]map[string]any)
+	if !ok {
+		panic(fmt.Errorf("sqlx.MapSliceRowsBinder: expect type *[]map[string]any, but got %T", dst))
+	}
+
+	columns, err := scanner.Columns()
+	if err != nil {
+		return
+	}
+
+	values := make([]any, len(columns))
+	dsts := make([]any, len(columns))
+	for i := range values {
+		dsts[i] = &values[i]
+	}
+
+	rows := *dstps
+	if cap(rows) == 0 {
+		rows = make([]map[string]any, 0, getrowscap(scanner, DefaultRowsCap))
+	}
+
+	for scanner.Next() {
+		if err = scanner.Scan(dsts...); err != nil {
+			return
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				row[column] = string(b)
+			} else {
+				row[column] = values[i]
+			}
+			values[i] = nil
+		}
+		rows = append(rows, row)
+	}
+
+	*dstps = rows
+	return
+}
+
 func commonSliceRowsBinder(scanner RowScanner, dst any) (err error) {
 	oldvf := reflect.ValueOf(dst)
 	if oldvf.Kind() != reflect.Pointer {
@@ -0,0 +1,100 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// ScanStructLenient is the same as Scan with a pointer to struct s,
+// but scans each column independently: the column failing to be converted
+// is recorded into errs by its name and left as it is, and the others
+// are still filled, so that the partial data of a messy row is usable.
+//
+// The column without struct field is discarded and recorded into errs.
+// The error that fails to scan the whole row is recorded by the empty name.
+//
+// If all the columns are scanned successfully, return nil.
+func (r Rows) ScanStructLenient(s any) (errs map[string]error) {
+	if !IsPointerToStruct(s) {
+		panic("sqlx.Rows.ScanStructLenient: not a pointer to struct")
+	}
+
+	record := func(column string, err error) {
+		if errs == nil {
+			errs = make(map[string]error, 4)
+		}
+		errs[column] = err
+	}
+
+	columns, err := r.Columns()
+	if err != nil {
+		record("", err)
+		return
+	}
+
+	err = scanColumnsToStruct(func(values ...any) error {
+		for i, value := range values {
+			if _, ok := value.(discardScanner); ok {
+				record(columns[i], fmt.Errorf("sqlx: no struct field for column %q", columns[i]))
+			} else {
+				values[i] = lenientScanner{Value: value, Column: columns[i], Record: record}
+			}
+		}
+		return r.Rows.Scan(values...)
+	}, columns, s, false)
+
+	if err != nil {
+		record("", err)
+	} else if r.observer != nil {
+		r.observer.rows++
+	}
+	return
+}
+
+// lenientScanner is a sql.Scanner to scan the column value into Value,
+// which records the error instead of returning it.
+type lenientScanner struct {
+	Value  any
+	Column string
+	Record func(column string, err error)
+}
+
+func (s lenientScanner) Scan(src any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+		if err != nil {
+			s.Record(s.Column, err)
+			err = nil
+		}
+	}()
+
+	switch v := s.Value.(type) {
+	case sql.Scanner:
+		return v.Scan(src)
+
+	case *sql.RawBytes:
+		if src == nil {
+			*v = nil
+			return
+		}
+		*v, err = toBytes(src)
+		return
+	}
+	return GeneralScanner{Value: s.Value}.Scan(src)
+}
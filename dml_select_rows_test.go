@@ -0,0 +1,319 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestRowsBindStructWithJSON(t *testing.T) {
+	type Attrs struct {
+		Color string `json:"color"`
+		Size  int    `json:"size"`
+	}
+
+	type Item struct {
+		Id    int64             `sql:"id"`
+		Attrs Attrs             `sql:"attrs"`
+		Tags  []string          `sql:"tags"`
+		Extra map[string]string `sql:"extra"`
+	}
+
+	db, _ := newTestDB(MySQL, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "attrs", "tags", "extra"},
+			Rows: [][]driver.Value{
+				{int64(1), []byte(`{"color":"red","size":2}`), []byte(`["a","b"]`), "{\"k\":\"v\"}"},
+				{int64(2), nil, []byte(""), "null"},
+			},
+		}, nil
+	})
+
+	var items []Item
+	err := Selects("id", "attrs", "tags", "extra").From("item").SetDB(db).QueryRows().Bind(&items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []Item{
+		{Id: 1, Attrs: Attrs{Color: "red", Size: 2}, Tags: []string{"a", "b"}, Extra: map[string]string{"k": "v"}},
+		{Id: 2},
+	}
+	if !reflect.DeepEqual(expects, items) {
+		t.Errorf("expect %+v, but got %+v", expects, items)
+	}
+}
+
+func TestDBWithRowsObserver(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]driver.Value{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}},
+		}, nil
+	})
+
+	var calls, rows int
+	var query string
+	db = db.WithRowsObserver(func(q string, n int, dur time.Duration) {
+		calls, rows, query = calls+1, n, q
+		if dur <= 0 {
+			t.Errorf("expect a positive duration, but got %s", dur)
+		}
+	})
+
+	var users []testUser
+	if err := db.Select("id").Select("name").From("user").QueryRows().Bind(&users); err != nil {
+		t.Fatal(err)
+	} else if len(users) != 3 {
+		t.Errorf("expect 3 users, but got %d", len(users))
+	}
+
+	if calls != 1 {
+		t.Errorf("expect the observer to be called once, but got %d", calls)
+	}
+	if rows != 3 {
+		t.Errorf("expect 3 observed rows, but got %d", rows)
+	}
+	if expect := "SELECT `id`, `name` FROM `user`"; query != expect {
+		t.Errorf("expect query '%s', but got '%s'", expect, query)
+	}
+}
+
+func TestSelectBuilderBindRowsContext(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]driver.Value{{int64(1), "a"}, {int64(2), "b"}},
+		}, nil
+	})
+
+	var users []testUser
+	if err := db.Select("id").Select("name").From("user").BindRowsContext(context.Background(), &users); err != nil {
+		t.Fatal(err)
+	}
+	expects := []testUser{{Id: 1, Name: "a"}, {Id: 2, Name: "b"}}
+	if !reflect.DeepEqual(expects, users) {
+		t.Errorf("expect %+v, but got %+v", expects, users)
+	}
+
+	names := make(map[int64]string)
+	if err := db.Select("id").Select("name").From("user").BindRows(names); err != nil {
+		t.Fatal(err)
+	} else if expect := map[int64]string{1: "a", 2: "b"}; !reflect.DeepEqual(expect, names) {
+		t.Errorf("expect %+v, but got %+v", expect, names)
+	}
+}
+
+type testSetterUser struct {
+	Id   int64  `sql:"id"`
+	name string `sql:"name,setter=SetName"`
+}
+
+func (u *testSetterUser) SetName(name string) { u.name = "user:" + name }
+
+func TestRowsBindStructWithSetter(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]driver.Value{{int64(1), "a"}, {int64(2), []byte("b")}},
+		}, nil
+	})
+
+	var users []testSetterUser
+	if err := db.Select("id").Select("name").From("user").QueryRows().Bind(&users); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []testSetterUser{{Id: 1, name: "user:a"}, {Id: 2, name: "user:b"}}
+	if !reflect.DeepEqual(expects, users) {
+		t.Errorf("expect %+v, but got %+v", expects, users)
+	}
+}
+
+func TestRowsBindOne(t *testing.T) {
+	tests := []struct {
+		rows  [][]driver.Value
+		found bool
+		err   error
+	}{
+		{rows: nil, found: false},
+		{rows: [][]driver.Value{{int64(1), "a", int64(10)}}, found: true},
+		{rows: [][]driver.Value{{int64(1), "a", int64(10)}, {int64(2), "b", int64(20)}}, found: true, err: ErrMultipleRows},
+	}
+
+	for i, test := range tests {
+		db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+			return testresult{Columns: []string{"id", "name", "age"}, Rows: test.rows}, nil
+		})
+
+		var user testUser
+		found, err := db.SelectStruct(user).From("user").Where(op.Equal("name", "a")).QueryRows().BindOne(&user)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%d: expect error '%v', but got '%v'", i, test.err, err)
+		} else if found != test.found {
+			t.Errorf("%d: expect found %v, but got %v", i, test.found, found)
+		} else if found && (user.Id != 1 || user.Name != "a" || user.Age != 10) {
+			t.Errorf("%d: unexpected user %+v", i, user)
+		}
+	}
+}
+
+func TestRowsBindMapSlice(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "note"},
+			Rows: [][]driver.Value{
+				{int64(1), []byte("a"), nil},
+				{int64(2), "b", []byte("c")},
+			},
+		}, nil
+	})
+
+	var rows []map[string]any
+	if err := db.QueryRows("SELECT id, name, note FROM user").Bind(&rows); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []map[string]any{
+		{"id": int64(1), "name": "a", "note": nil},
+		{"id": int64(2), "name": "b", "note": "c"},
+	}
+	if !reflect.DeepEqual(expects, rows) {
+		t.Errorf("expect rows %v, but got %v", expects, rows)
+	}
+}
+
+func TestRowsScanStructLenient(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age"},
+			Rows:    [][]driver.Value{{int64(1), "a", "abc"}},
+		}, nil
+	})
+
+	rows := db.SelectStruct(testUser{}).From("user").QueryRows()
+	if rows.Err != nil {
+		t.Fatal(rows.Err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		t.Fatal("expect a row, but got none")
+	}
+
+	var user testUser
+	errs := rows.ScanStructLenient(&user)
+	if len(errs) != 1 || errs["age"] == nil {
+		t.Errorf("expect an error of the column age, but got %v", errs)
+	}
+	if expect := (testUser{Id: 1, Name: "a"}); user != expect {
+		t.Errorf("expect %+v, but got %+v", expect, user)
+	}
+}
+
+func TestRowsScanStructLenientRawBytes(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "data", "extra"},
+			Rows:    [][]driver.Value{{int64(1), []byte("abc"), "xyz"}},
+		}, nil
+	})
+
+	rows := db.Selects("id", "data", "extra").From("user").QueryRows()
+	if rows.Err != nil {
+		t.Fatal(rows.Err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		t.Fatal("expect a row, but got none")
+	}
+
+	var record struct {
+		Id   int64        `sql:"id"`
+		Data sql.RawBytes `sql:"data"`
+	}
+	errs := rows.ScanStructLenient(&record)
+	if len(errs) != 1 || errs["extra"] == nil {
+		t.Errorf("expect an error of the column extra, but got %v", errs)
+	}
+	if record.Id != 1 || string(record.Data) != "abc" {
+		t.Errorf("expect id=1 and data=abc, but got %+v", record)
+	}
+}
+
+func TestRowsBindWithTotal(t *testing.T) {
+	db, tdb := newTestDB(Postgres, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age", "total"},
+			Rows: [][]driver.Value{
+				{int64(3), "c", int64(30), int64(5)},
+				{int64(4), "d", int64(40), int64(5)},
+			},
+		}, nil
+	})
+
+	var users []testUser
+	total, err := db.SelectStruct(testUser{}).From("user").OrderByAsc("id").
+		PaginateWithTotal(2, 2, "total").QueryRows().BindWithTotal(&users, "total")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if total != 5 {
+		t.Errorf("expect total %d, but got %d", 5, total)
+	}
+
+	expects := []testUser{{Id: 3, Name: "c", Age: 30}, {Id: 4, Name: "d", Age: 40}}
+	if !slices.Equal(expects, users) {
+		t.Errorf("expect users %+v, but got %+v", expects, users)
+	}
+
+	stmts := []string{`SELECT "id", "name", "age", COUNT(*) OVER () AS "total" FROM "user" ORDER BY "id" ASC LIMIT 2 OFFSET 2`}
+	if s := tdb.Statements(); !slices.Equal(stmts, s) {
+		t.Errorf("expect statements %q, but got %q", stmts, s)
+	}
+}
+
+func TestRowsStrictRowScanWrapper(t *testing.T) {
+	db, _ := newTestDB(MySQL, func(query string, args []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name", "age", "extra"},
+			Rows:    [][]driver.Value{{int64(1), "a", int64(10), "x"}},
+		}, nil
+	})
+
+	var users []testUser
+	if err := db.QueryRows("SELECT * FROM user").Bind(&users); err != nil {
+		t.Fatal(err)
+	} else if expect := []testUser{{Id: 1, Name: "a", Age: 10}}; !slices.Equal(expect, users) {
+		t.Errorf("expect users %+v, but got %+v", expect, users)
+	}
+
+	users = nil
+	err := db.QueryRows("SELECT * FROM user").WithScanner(StrictRowScanWrapper).Bind(&users)
+	if expect := `sqlx: no struct field for column "extra"`; err == nil || err.Error() != expect {
+		t.Errorf("expect error '%s', but got '%v'", expect, err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "slices"
+
+// Snapshot returns a factory to produce the fresh clones of the current
+// state of the SELECT builder, which is used as a template query,
+// so the produced builders can be modified without affecting the template.
+//
+// Notice: the conditions are shared by the clones, because they are
+// not modified by the builder.
+func (b *SelectBuilder) Snapshot() func() *SelectBuilder {
+	template := b.clone()
+	return template.clone
+}
+
+// Clone returns a copy of the SELECT builder, which deep-copies the columns,
+// tables, joins, conditions, GROUP BY, HAVING, ORDER BY and the subqueries,
+// so that it can be modified independently, such as branching the base query
+// for the count and data queries.
+//
+// Notice: the db, with the interceptor, and the rows binder are shared
+// by reference, and the conditions are shared as they are immutable.
+func (b *SelectBuilder) Clone() *SelectBuilder {
+	return b.clone()
+}
+
+// ToCount returns a new SELECT builder to count the total rows of the query,
+// such as the total count for pagination, which selects COUNT(*) over
+// the same tables, joins and WHERE conditions, but drops the selected columns,
+// ORDER BY, LIMIT, OFFSET, the pagination and the row lock.
+//
+// If the query has GROUP BY, DISTINCT or UNION, it is wrapped as a subquery
+// with its selected columns to count the rows, such as
+//
+//	SELECT COUNT(*) FROM (SELECT ... GROUP BY ...) AS _count
+func (b *SelectBuilder) ToCount() *SelectBuilder {
+	c := b.clone()
+	c.orderbys = nil
+	c.limit = 0
+	c.offset = 0
+	c.page = nil
+	c.lock = rowLock{}
+
+	if len(c.groupbys) > 0 || c.distinct || len(c.distinctOn) > 0 || len(c.unions) > 0 {
+		c.comment = ""
+		return Select(Count("*")).FromSubquery(c, "_count").SetDB(b.db).Comment(b.comment)
+	}
+
+	c.columns = nil
+	c.ignores = nil
+	return c.Select(Count("*"))
+}
+
+func (b *SelectBuilder) clone() *SelectBuilder {
+	if b == nil {
+		return nil
+	}
+
+	c := *b
+	c.distinctOn = slices.Clone(b.distinctOn)
+	c.ftables = slices.Clone(b.ftables)
+	c.jtables = slices.Clone(b.jtables)
+	c.columns = slices.Clone(b.columns)
+	c.wheres = slices.Clone(b.wheres)
+	c.ignores = slices.Clone(b.ignores)
+	c.havings = slices.Clone(b.havings)
+	c.hconds = slices.Clone(b.hconds)
+	c.groupbys = slices.Clone(b.groupbys)
+	c.orderbys = slices.Clone(b.orderbys)
+	c.unions = slices.Clone(b.unions)
+	c.ctes = slices.Clone(b.ctes)
+	c.lock.tables = slices.Clone(b.lock.tables)
+
+	for i := range c.ftables {
+		c.ftables[i].Query = c.ftables[i].Query.clone()
+	}
+	for i := range c.jtables {
+		c.jtables[i].Ons = slices.Clone(c.jtables[i].Ons)
+	}
+	for i := range c.unions {
+		c.unions[i].query = c.unions[i].query.clone()
+	}
+	for i := range c.ctes {
+		c.ctes[i].query = c.ctes[i].query.clone()
+	}
+
+	return &c
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestSelectBuilderSnapshot(t *testing.T) {
+	template := Selects("id", "name").From("user").Where(op.Equal("status", 1)).OrderByDesc("id")
+	newQuery := template.Snapshot()
+
+	q1 := newQuery().Where(op.Equal("age", 18)).Select("age").Limit(10)
+	q1.OrderByAsc("name")
+
+	q2 := newQuery()
+
+	expect1 := "SELECT `id`, `name`, `age` FROM `user` WHERE (`status`=? AND `age`=?) ORDER BY `id` DESC, `name` ASC LIMIT 10"
+	if sql, _ := q1.Build(); sql != expect1 {
+		t.Errorf("expect sql '%s', but got '%s'", expect1, sql)
+	}
+
+	expect2 := "SELECT `id`, `name` FROM `user` WHERE `status`=? ORDER BY `id` DESC"
+	if sql, _ := q2.Build(); sql != expect2 {
+		t.Errorf("expect sql '%s', but got '%s'", expect2, sql)
+	}
+
+	// Modifying the template does not affect the snapshot.
+	template.Where(op.Equal("deleted", 0))
+	if sql, _ := newQuery().Build(); sql != expect2 {
+		t.Errorf("expect sql '%s', but got '%s'", expect2, sql)
+	}
+}
+
+func TestSelectBuilderClone(t *testing.T) {
+	base := Selects("id", "name").From("user").Where(op.Equal("status", 1)).GroupBy("id")
+	count := base.Clone().Where(op.Greater("age", 18)).GroupBy("name")
+	count.columns[0].Column = "uid"
+
+	expect := "SELECT `id`, `name` FROM `user` WHERE `status`=? GROUP BY `id`"
+	if sql := base.String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	expect = "SELECT `uid`, `name` FROM `user` WHERE (`status`=? AND `age`>?) GROUP BY `id`, `name`"
+	if sql := count.String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+}
+
+func TestSelectBuilderToCount(t *testing.T) {
+	base := Selects("u.id", "u.name").FromAlias("user", "u").
+		JoinLeft("order", "o", On("o.user_id", "u.id")).
+		Where(op.Equal("u.status", 1)).OrderByDesc("u.id").Limit(10).Offset(20)
+
+	expect := "SELECT COUNT(*) FROM `user` AS `u` LEFT JOIN `order` AS `o` ON `o`.`user_id`=`u`.`id` WHERE `u`.`status`=?"
+	if sql := base.ToCount().String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	expect = "SELECT `u`.`id`, `u`.`name` FROM `user` AS `u` LEFT JOIN `order` AS `o` ON `o`.`user_id`=`u`.`id` WHERE `u`.`status`=? ORDER BY `u`.`id` DESC LIMIT 10 OFFSET 20"
+	if sql := base.String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	grouped := Select("status").SelectCount("id").From("user").GroupBy("status").OrderByAsc("status").Limit(10)
+	expect = "SELECT COUNT(*) FROM (SELECT `status`, COUNT(`id`) FROM `user` GROUP BY `status`) AS `_count`"
+	if sql := grouped.ToCount().String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+}
@@ -36,6 +36,11 @@ func (db *DB) SelectStructWithTable(s any, table string) *SelectBuilder {
 	return SelectStructWithTable(s, table).SetDB(db)
 }
 
+// SelectStructUnqualified is equal to SelectStructUnqualified(s).
+func (db *DB) SelectStructUnqualified(s any) *SelectBuilder {
+	return SelectStructUnqualified(s).SetDB(db)
+}
+
 // SelectStruct is equal to SelectStructWithTable(s, "").
 func SelectStruct(s any) *SelectBuilder {
 	return SelectStructWithTable(s, "")
@@ -46,6 +51,11 @@ func SelectStructWithTable(s any, table string) *SelectBuilder {
 	return new(SelectBuilder).SelectStructWithTable(s, table)
 }
 
+// SelectStructUnqualified is equal to NewSelectBuilder().SelectStructUnqualified(s).
+func SelectStructUnqualified(s any) *SelectBuilder {
+	return new(SelectBuilder).SelectStructUnqualified(s)
+}
+
 // SelectStruct is equal to b.SelectStructWithTable(s, "").
 func (b *SelectBuilder) SelectStruct(s any) *SelectBuilder {
 	return b.SelectStructWithTable(s, "")
@@ -56,6 +66,11 @@ func (b *SelectBuilder) SelectStruct(s any) *SelectBuilder {
 // to modify the column name.
 //
 // If the value of the tag is "-", however, the field will be ignored.
+//
+// The columns of the nested struct field are prefixed by its tag name,
+// or its field name if no tag name and not embedded, joined by Sep,
+// such as "Address_city" for the field "Address Addr", which may be
+// overridden by the tag argument "prefix", such as `sql:",prefix=addr_"`.
 func (b *SelectBuilder) SelectStructWithTable(s any, table string) *SelectBuilder {
 	columns := defaultGetColumnsFromStruct(s, table)
 	b.growcolumns(len(columns))
@@ -65,6 +80,19 @@ func (b *SelectBuilder) SelectStructWithTable(s any, table string) *SelectBuilde
 	return b
 }
 
+// SelectStructUnqualified is the same as SelectStruct, but strips the table
+// qualifier from the selected columns, including those returned by the method
+// Columns(table string) []Namer of s, which is useful for the single-table
+// queries and views. The prefixes of the nested structs are still applied.
+func (b *SelectBuilder) SelectStructUnqualified(s any) *SelectBuilder {
+	columns := defaultGetColumnsFromStruct(s, "")
+	b.growcolumns(len(columns))
+	for _, c := range columns {
+		b.SelectAlias(extractName(c.Name), c.Alias)
+	}
+	return b
+}
+
 func defaultGetColumnsFromStruct(s any, table string) []Namer {
 	if s == nil {
 		return nil
@@ -142,12 +170,12 @@ func selectStruct(columns []Namer, vtype reflect.Type, ftable, prefix string) []
 	for i := 0; i < _len; i++ {
 		ftype := vtype.Field(i)
 
-		// var targs []string
+		var targs []string
 		tname := ftype.Tag.Get("sql")
 		if index := strings.IndexByte(tname, ','); index > -1 {
-			// if args := tname[index+1:]; args != "" {
-			// 	targs = strings.Split(args, ",")
-			// }
+			if args := tname[index+1:]; args != "" {
+				targs = strings.Split(args, ",")
+			}
 			tname = strings.TrimSpace(tname[:index])
 		}
 
@@ -162,7 +190,7 @@ func selectStruct(columns []Namer, vtype reflect.Type, ftable, prefix string) []
 
 		isvaluer := ftype.Type.Implements(_valuertype)
 		if !isvaluer && ftype.Type.Kind() == reflect.Struct && ftype.Type != _timetype {
-			columns = selectStruct(columns, ftype.Type, ftable, formatFieldName(prefix, tname))
+			columns = selectStruct(columns, ftype.Type, ftable, formatFieldName(prefix, structPrefix(ftype, tname, targs)))
 		} else {
 			name = formatFieldName(prefix, name)
 			if ftable != "" {
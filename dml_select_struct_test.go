@@ -15,6 +15,7 @@
 package sqlx
 
 import (
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -155,3 +156,128 @@ func TestSelectBuilderSelectStructWithTable(t *testing.T) {
 		t.Errorf("expect the length of typetables is 4, but got %d", num)
 	}
 }
+
+type testQualifiedColumns struct{}
+
+func (testQualifiedColumns) Columns(table string) []Namer {
+	return []Namer{{Name: "v.id"}, {Name: "v.name", Alias: "username"}}
+}
+
+func TestSelectBuilderSelectStructUnqualified(t *testing.T) {
+	type Inner struct {
+		Name string `sql:"name"`
+	}
+	type S struct {
+		Id    int64 `sql:"id"`
+		Inner Inner `sql:"inner"`
+	}
+
+	expects := "SELECT `t`.`id`, `t`.`inner_name` FROM `t`"
+	if q := SelectStructWithTable(S{}, "t").From("t").String(); q != expects {
+		t.Errorf(`expect sql "%s", but got "%s"`, expects, q)
+	}
+
+	expects = "SELECT `id`, `inner_name` FROM `t`"
+	if q := SelectStructUnqualified(S{}).From("t").String(); q != expects {
+		t.Errorf(`expect sql "%s", but got "%s"`, expects, q)
+	}
+
+	expects = "SELECT `id`, `name` AS `username` FROM `v`"
+	if q := SelectStructUnqualified(testQualifiedColumns{}).From("v").String(); q != expects {
+		t.Errorf(`expect sql "%s", but got "%s"`, expects, q)
+	}
+}
+
+func TestSelectBuilderSelectStructNestedPrefix(t *testing.T) {
+	type Addr struct {
+		City string `sql:"city"`
+	}
+	type Base struct {
+		Id int64 `sql:"id"`
+	}
+	type S struct {
+		Base
+		City    string `sql:"city"`
+		Address Addr
+		Home    Addr `sql:"home"`
+	}
+
+	expects := "SELECT `id`, `city`, `Address_city`, `home_city` FROM `t`"
+	if q := SelectStruct(S{}).From("t").String(); q != expects {
+		t.Errorf(`expect sql "%s", but got "%s"`, expects, q)
+	}
+
+	var s S
+	columns := []string{"id", "city", "Address_city", "home_city"}
+	err := ScanColumnsToStruct(func(vs ...any) error {
+		*vs[1].(*string) = "a"
+		*vs[2].(*string) = "b"
+		*vs[3].(*string) = "c"
+		return nil
+	}, columns, &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := (S{City: "a", Address: Addr{City: "b"}, Home: Addr{City: "c"}}); s != expect {
+		t.Errorf("expect %+v, but got %+v", expect, s)
+	}
+}
+
+func TestScanColumnsToStructUnknownColumn(t *testing.T) {
+	type S struct {
+		Id   int64  `sql:"id"`
+		Name string `sql:"name"`
+	}
+
+	scan := func(vs ...any) error {
+		*vs[0].(*int64) = 1
+		*vs[1].(*string) = "a"
+		return vs[2].(sql.Scanner).Scan("x")
+	}
+
+	var s S
+	columns := []string{"id", "name", "extra"}
+	if err := ScanColumnsToStruct(scan, columns, &s); err != nil {
+		t.Fatal(err)
+	} else if expect := (S{Id: 1, Name: "a"}); s != expect {
+		t.Errorf("expect %+v, but got %+v", expect, s)
+	}
+
+	err := ScanColumnsToStructStrict(scan, columns, &S{})
+	if expect := `sqlx: no struct field for column "extra"`; err == nil || err.Error() != expect {
+		t.Errorf("expect error '%s', but got '%v'", expect, err)
+	}
+}
+
+func TestSelectBuilderSelectStructCustomPrefix(t *testing.T) {
+	type Addr struct {
+		City string `sql:"city"`
+	}
+	type Home struct {
+		City string `sql:"city"`
+	}
+	type S struct {
+		Addr `sql:",prefix=addr_"`
+		Home `sql:",prefix=home"`
+	}
+
+	expects := "SELECT `addr_city`, `home_city` FROM `t`"
+	if q := SelectStruct(S{}).From("t").String(); q != expects {
+		t.Errorf(`expect sql "%s", but got "%s"`, expects, q)
+	}
+
+	var s S
+	err := ScanColumnsToStruct(func(vs ...any) error {
+		*vs[0].(*string) = "a"
+		*vs[1].(*string) = "b"
+		return nil
+	}, []string{"addr_city", "home_city"}, &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expect := (S{Addr: Addr{City: "a"}, Home: Home{City: "b"}}); s != expect {
+		t.Errorf("expect %+v, but got %+v", expect, s)
+	}
+}
@@ -66,6 +66,102 @@ func ExampleSelectBuilder_GroupBy() {
 	// [123]
 }
 
+func ExampleSelectBuilder_ResetGroupBy() {
+	s := Select("*").From("table").GroupBy("a").GroupBy("b")
+	fmt.Println(s.String())
+
+	s.ResetGroupBy().GroupBy("c")
+	fmt.Println(s.String())
+
+	// Output:
+	// SELECT * FROM `table` GROUP BY `a`, `b`
+	// SELECT * FROM `table` GROUP BY `c`
+}
+
+func ExampleSelectBuilder_GroupByRollup() {
+	s1 := Select("a").Select("b").Select(Sum("n")).From("table").GroupByRollup("a", "b").Having("SUM(n) > 10")
+	s2 := Select("a").Select("b").Select(Sum("n")).From("table").GroupByRollup("a", "b").SetDB(&DB{Dialect: Postgres})
+
+	fmt.Println(s1.String())
+	fmt.Println(s2.String())
+
+	// Output:
+	// SELECT `a`, `b`, SUM(`n`) FROM `table` GROUP BY `a`, `b` WITH ROLLUP HAVING SUM(n) > 10
+	// SELECT "a", "b", SUM("n") FROM "table" GROUP BY ROLLUP("a", "b")
+}
+
+func ExampleSelectBuilder_DistinctOn() {
+	db := &DB{Dialect: Postgres}
+	s := db.Selects("user_id", "amount", "created_at").DistinctOn("user_id").From("order").
+		OrderByAsc("user_id").OrderByDesc("created_at")
+
+	fmt.Println(s.String())
+
+	// Output:
+	// SELECT DISTINCT ON ("user_id") "user_id", "amount", "created_at" FROM "order" ORDER BY "user_id" ASC, "created_at" DESC
+}
+
+func ExampleSelectBuilder_WhereIf() {
+	name, status, age := "", "active", 0
+	sql, args := Selects("id", "name").From("user").
+		WhereIf(name != "", op.Like("name", name)).
+		WhereIfNotZero("status", status).
+		WhereIfNotZero("age", age).
+		Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT `id`, `name` FROM `user` WHERE `status`=?
+	// [active]
+}
+
+func ExampleSelectBuilder_BuildWithDialect() {
+	s := Selects("id", "name").From("user").Where(op.Equal("id", 123)).Limit(10)
+	for _, dialect := range []Dialect{MySQL, Postgres, Sqlite3} {
+		sql, args := s.BuildWithDialect(dialect)
+		fmt.Println(sql, args.Args())
+		args.Release()
+	}
+	fmt.Println(s.db == nil)
+
+	// Output:
+	// SELECT `id`, `name` FROM `user` WHERE `id`=? LIMIT 10 [123]
+	// SELECT "id", "name" FROM "user" WHERE "id"=$1 LIMIT 10 [123]
+	// SELECT "id", "name" FROM "user" WHERE "id"=? LIMIT 10 [123]
+	// true
+}
+
+func ExampleSelectBuilder_OrderByNulls() {
+	s := Selects("id", "score").From("user").
+		OrderByNulls("score", Desc, NullsLast).
+		OrderByNulls("id", Asc, NullsFirst)
+
+	fmt.Println(s.String())
+	fmt.Println(s.SetDB(&DB{Dialect: Postgres}).String())
+
+	// Output:
+	// SELECT `id`, `score` FROM `user` ORDER BY CASE WHEN `score` IS NULL THEN 1 ELSE 0 END, `score` DESC, CASE WHEN `id` IS NULL THEN 0 ELSE 1 END, `id` ASC
+	// SELECT "id", "score" FROM "user" ORDER BY "score" DESC NULLS LAST, "id" ASC NULLS FIRST
+}
+
+func ExampleSelectBuilder_HavingCond() {
+	db := &DB{Dialect: Postgres}
+	sql, args := db.Select("region").SelectAlias(Count("*"), "num").SelectAlias(Sum("amount"), "total").
+		From("order").Where(op.Equal("status", "paid"), op.GreaterEqual("created_at", "2025-01-01")).
+		GroupBy("region").Having("COUNT(*) > 1").
+		HavingCond(op.Greater(Sum("amount"), 1000), op.Less(Count("DISTINCT user_id"), 500)).
+		OrderByDesc("total").Limit(10).Offset(20).Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT "region", COUNT(*) AS "num", SUM("amount") AS "total" FROM "order" WHERE ("status"=$1 AND "created_at">=$2) GROUP BY "region" HAVING COUNT(*) > 1 AND (SUM("amount")>$3 AND COUNT(DISTINCT user_id)<$4) ORDER BY "total" DESC LIMIT 10 OFFSET 20
+	// [paid 2025-01-01 1000 500]
+}
+
 func ExampleSelectBuilder_OrderBy() {
 	s1 := Select("*").From("table").Where(op.Equal("id", 123)).OrderBy("time", Asc)
 	s2 := Select("*").From("table").Where(op.Equal("id", 123)).OrderBy("time", Desc)
@@ -86,6 +182,29 @@ func ExampleSelectBuilder_OrderBy() {
 	// [123]
 }
 
+func ExampleSelectBuilder_OrderBy_alias() {
+	s := Select("o.region").SelectAlias(Sum("o.amount"), "total").FromAlias("order", "o").
+		GroupBy("o.region").OrderByDesc("total")
+
+	sql1, _ := s.Build()
+	sql2, _ := s.SetDB(&DB{Dialect: Postgres}).Build()
+
+	fmt.Println(sql1)
+	fmt.Println(sql2)
+
+	// Output:
+	// SELECT `o`.`region`, SUM(`o`.`amount`) AS `total` FROM `order` AS `o` GROUP BY `o`.`region` ORDER BY `total` DESC
+	// SELECT "o"."region", SUM("o"."amount") AS "total" FROM "order" AS "o" GROUP BY "o"."region" ORDER BY "total" DESC
+}
+
+func ExampleSelectBuilder_SelectRaw() {
+	sql, _ := Select("id").SelectRaw("myschema.my_func(id)", "x").From("table").Build()
+	fmt.Println(sql)
+
+	// Output:
+	// SELECT `id`, myschema.my_func(id) AS `x` FROM `table`
+}
+
 func ExampleSelectBuilder_Limit() {
 	s := Select("*").From("table").Where(op.Equal("id", 123)).
 		OrderByAsc("time").Limit(10).Offset(100)
@@ -99,6 +218,80 @@ func ExampleSelectBuilder_Limit() {
 	// [123]
 }
 
+func ExampleSelectBuilder_Offset() {
+	sql1, _ := Select("*").From("table").OrderByAsc("id").Offset(100).Build()
+	sql2, _ := Select("*").From("table").OrderByAsc("id").Offset(100).SetDB(&DB{Dialect: Postgres}).Build()
+
+	fmt.Println(sql1)
+	fmt.Println(sql2)
+
+	// Output:
+	// SELECT * FROM `table` ORDER BY `id` ASC LIMIT 18446744073709551615 OFFSET 100
+	// SELECT * FROM "table" ORDER BY "id" ASC OFFSET 100
+}
+
+func ExampleSelectBuilder_Union() {
+	db := &DB{Dialect: Postgres}
+	active := db.Select("id").From("user").Where(op.Equal("status", 1))
+	admins := db.Select("id").From("admin").Where(op.Equal("level", 9))
+	banned := db.Select("id").From("banned").Where(op.Equal("reason", "spam")).OrderByDesc("id").Limit(5)
+
+	sql, args := active.Union(admins).UnionAll(banned).OrderByAsc("id").Limit(10).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT "id" FROM "user" WHERE "status"=$1 UNION SELECT "id" FROM "admin" WHERE "level"=$2 UNION ALL (SELECT "id" FROM "banned" WHERE "reason"=$3 ORDER BY "id" DESC LIMIT 5) ORDER BY "id" ASC LIMIT 10
+	// [1 9 spam]
+}
+
+func ExampleSelectBuilder_FromSubquery() {
+	db := &DB{Dialect: Postgres}
+	sub := db.Select("user_id").SelectAlias(Count("*"), "num").
+		From("order").Where(op.Equal("status", 1)).GroupBy("user_id")
+
+	sql, args := db.Select("u.name").Select("t.num").FromSubquery(sub, "t").
+		Join("user", "u", On("u.id", "t.user_id")).
+		Where(op.Greater("t.num", 10)).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT "u"."name", "t"."num" FROM (SELECT "user_id", COUNT(*) AS "num" FROM "order" WHERE "status"=$1 GROUP BY "user_id") AS "t" JOIN "user" AS "u" ON "u"."id"="t"."user_id" WHERE "t"."num">$2
+	// [1 10]
+}
+
+func ExampleSelectBuilder_With() {
+	db := &DB{Dialect: Postgres}
+	paid := db.Select("user_id").SelectAlias(Sum("amount"), "total").
+		From("order").Where(op.Equal("status", "paid")).GroupBy("user_id")
+	vips := db.Select("user_id").From("paid").Where(op.Greater("total", 1000))
+
+	sql, args := db.Select("name").From("user").
+		With("paid", paid).With("vips", vips).
+		Where(op.Equal("active", true), InSubquery("id", db.Select("user_id").From("vips"))).
+		Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// WITH "paid" AS (SELECT "user_id", SUM("amount") AS "total" FROM "order" WHERE "status"=$1 GROUP BY "user_id"), "vips" AS (SELECT "user_id" FROM "paid" WHERE "total">$2) SELECT "name" FROM "user" WHERE ("active"=$3 AND "id" IN (SELECT "user_id" FROM "vips"))
+	// [paid 1000 true]
+}
+
+func ExampleSelectBuilder_WithRecursive() {
+	tree := Select("id").From("category").Where(op.Equal("id", 1)).
+		UnionAll(Select("c.id").FromAlias("category", "c").Join("tree", "t", On("c.parent_id", "t.id")))
+
+	sql, args := Select("id").From("tree").WithRecursive("tree", tree).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// WITH RECURSIVE `tree` AS (SELECT `id` FROM `category` WHERE `id`=? UNION ALL SELECT `c`.`id` FROM `category` AS `c` JOIN `tree` AS `t` ON `c`.`parent_id`=`t`.`id`) SELECT `id` FROM `tree`
+	// [1]
+}
+
 func ExampleSelectBuilder_Join() {
 	s := Select("*").From("table1").Join("table2", "", On("table1.id", "table2.id")).
 		Where(op.Equal("table1.id", 123)).OrderByAsc("table1.time").Limit(10).Offset(100)
@@ -145,3 +338,29 @@ func ExampleSelectBuilder_IgnoreColumns() {
 	// SELECT `id`, `name`, `age` FROM `table` WHERE `id`=?
 	// [123]
 }
+
+func ExampleSelectBuilder_WithQuoteChar() {
+	sql, args := (&DB{Dialect: Postgres}).Select("u.id").SelectAlias("COUNT(o.id)", "total").
+		FromAlias("user", "u").JoinLeft("order", "o", On("o.uid", "u.id")).
+		Where(op.Equal("u.name", "abc")).GroupBy("u.id").WithQuoteChar('`', '`').Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT `u`.`id`, COUNT(`o`.`id`) AS `total` FROM `user` AS `u` LEFT JOIN `order` AS `o` ON `o`.`uid`=`u`.`id` WHERE `u`.`name`=$1 GROUP BY `u`.`id`
+	// [abc]
+}
+
+func ExampleSelectBuilder_SimplifySingletonIn() {
+	sql, args := Select("id").From("user").SimplifySingletonIn(true).
+		Where(op.In("id", []int{1}), op.NotIn("status", []string{"deleted"}), op.In("type", []int{1, 2})).
+		Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT `id` FROM `user` WHERE (`id`=? AND `status`<>? AND `type` IN (?, ?))
+	// [1 deleted 1 2]
+}
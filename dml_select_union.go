@@ -0,0 +1,63 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "bytes"
+
+type union struct {
+	query *SelectBuilder
+	all   bool
+}
+
+// Union appends the SELECT query other combined by UNION.
+//
+// The ORDER BY, LIMIT and OFFSET of b apply to the whole union,
+// and other is wrapped in the parentheses if it has its own ones.
+// The comment of other is ignored.
+func (b *SelectBuilder) Union(other *SelectBuilder) *SelectBuilder {
+	return b.union(other, false)
+}
+
+// UnionAll is the same as Union, but combines the query by UNION ALL.
+func (b *SelectBuilder) UnionAll(other *SelectBuilder) *SelectBuilder {
+	return b.union(other, true)
+}
+
+func (b *SelectBuilder) union(other *SelectBuilder, all bool) *SelectBuilder {
+	if other == nil {
+		panic("sqlx.SelectBuilder: the union query must not be nil")
+	}
+	b.unions = append(b.unions, union{query: other, all: all})
+	return b
+}
+
+func (b *SelectBuilder) buildUnions(buf *bytes.Buffer, args *ArgsBuilder, dialect Dialect) *ArgsBuilder {
+	for _, u := range b.unions {
+		if u.all {
+			buf.WriteString(" UNION ALL ")
+		} else {
+			buf.WriteString(" UNION ")
+		}
+
+		if u.query.hasOrderByLimit() {
+			buf.WriteByte('(')
+			args = u.query.build(buf, args, dialect)
+			buf.WriteByte(')')
+		} else {
+			args = u.query.build(buf, args, dialect)
+		}
+	}
+	return args
+}
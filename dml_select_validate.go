@@ -0,0 +1,55 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "fmt"
+
+// Validate checks the SELECT statement for the errors that the database
+// would only report when executing it, which should be called before Build.
+//
+// Now it checks that, for PostgreSQL, the ORDER BY columns must appear
+// in the selected columns, by the column or its alias, if DISTINCT is used,
+// except the raw expressions appended by OrderByExpr.
+func (b *SelectBuilder) Validate() error {
+	if b.distinct && b.getDialect().Name() == pqDialect {
+		for _, ob := range b.orderbys {
+			if !ob.Raw && !b.isSelected(ob.Column) {
+				return fmt.Errorf("sqlx.SelectBuilder: the ORDER BY column '%s' must appear in the selected columns for SELECT DISTINCT", ob.Column)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *SelectBuilder) isSelected(column string) bool {
+	for _, c := range b.columns {
+		if b.columnIsIgnored(c.Alias) || b.columnIsIgnored(extractName(c.Column)) {
+			continue
+		}
+
+		switch {
+		case c.Alias != "":
+			if column == c.Alias || (c.Expr == nil && column == c.Column) {
+				return true
+			}
+
+		case c.Expr == nil:
+			if column == c.Column || column == extractName(c.Column) {
+				return true
+			}
+		}
+	}
+	return false
+}
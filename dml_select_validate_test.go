@@ -0,0 +1,60 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "testing"
+
+func TestSelectBuilderValidate(t *testing.T) {
+	db := &DB{Dialect: Postgres}
+
+	q := db.Select("u.name").SelectAlias(Count("*"), "num").Distinct().FromAlias("user", "u").
+		OrderByAsc("name").OrderByDesc("num")
+	if err := q.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	q = db.Select("name").Distinct().From("user").OrderByDesc("created_at")
+	if err := q.Validate(); err == nil {
+		t.Errorf("expect an error, but got nil")
+	} else if expect := "sqlx.SelectBuilder: the ORDER BY column 'created_at' must appear in the selected columns for SELECT DISTINCT"; err.Error() != expect {
+		t.Errorf("expect error '%s', but got '%s'", expect, err.Error())
+	}
+
+	// MySQL does not require it, and no DISTINCT is not checked.
+	if err := Select("name").Distinct().From("user").OrderByDesc("created_at").Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.Select("name").From("user").OrderByDesc("created_at").Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSelectBuilderOrderByExpr(t *testing.T) {
+	q := Select("name").Distinct().From("user").OrderByExpr("FIELD(status, 1, 2, 3)").OrderByDesc("name")
+	expect := "SELECT DISTINCT `name` FROM `user` ORDER BY FIELD(status, 1, 2, 3), `name` DESC"
+	if sql := q.String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+
+	q = (&DB{Dialect: Postgres}).Select("name").Distinct().From("user").OrderByExpr("RANDOM()")
+	if err := q.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expect = `SELECT DISTINCT "name" FROM "user" ORDER BY RANDOM()`
+	if sql := q.String(); sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+}
@@ -50,6 +50,8 @@ type UpdateBuilder struct {
 	jtables []joinTable
 	setters []op.Updater
 	wheres  []op.Condition
+
+	returnings []string
 }
 
 // Table is equal to b.TableAlias(table, "")
@@ -130,6 +132,17 @@ func (b *UpdateBuilder) Set(updaters ...op.Updater) *UpdateBuilder {
 	return b
 }
 
+// SetRaw appends the raw "SET" fragment, such as "col=some_func(col, ?)",
+// which is emitted verbatim in order with the other setters,
+// and each "?" in expr is replaced with the placeholder of args in turn.
+//
+// The "?" in the single-quoted literals and the double-quoted identifiers
+// is not a placeholder, and "??" is emitted as a literal "?", such as
+// the jsonb operator of Postgres.
+func (b *UpdateBuilder) SetRaw(expr string, args ...any) *UpdateBuilder {
+	return b.Set(op.New(UpdateOpRaw, "", rawUpdater{Expr: expr, Args: args}).Updater())
+}
+
 // SetNamedArg is the same as Set, but uses the NamedArg as the Setter.
 func (b *UpdateBuilder) SetNamedArg(args ...sql.NamedArg) *UpdateBuilder {
 	if b.setters == nil {
@@ -166,6 +179,59 @@ func (b *UpdateBuilder) Where(andConditions ...op.Condition) *UpdateBuilder {
 	return b
 }
 
+// WhereIf is the same as Where, but only appends the conditions if cond is true.
+func (b *UpdateBuilder) WhereIf(cond bool, andConditions ...op.Condition) *UpdateBuilder {
+	if cond {
+		b.Where(andConditions...)
+	}
+	return b
+}
+
+// WhereIfNotZero appends the condition "column=value" only if value is not ZERO,
+// such as nil, "", 0, false, the zero time, etc.
+func (b *UpdateBuilder) WhereIfNotZero(column string, value any) *UpdateBuilder {
+	if !isZeroValue(value) {
+		b.Where(op.Equal(column, value))
+	}
+	return b
+}
+
+// Returning sets the RETURNING columns, which is not supported by MySQL and SQLite3.
+func (b *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
+	b.returnings = columns
+	return b
+}
+
+// QueryRows is equal to b.QueryRowsContext(context.Background()).
+func (b *UpdateBuilder) QueryRows() Rows {
+	return b.QueryRowsContext(context.Background())
+}
+
+// QueryRowsContext builds the sql with the RETURNING columns,
+// executes it and returns the returned rows.
+func (b *UpdateBuilder) QueryRowsContext(ctx context.Context) Rows {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRows(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
+// QueryRow is equal to b.QueryRowContext(context.Background()).
+func (b *UpdateBuilder) QueryRow() Row {
+	return b.QueryRowContext(context.Background())
+}
+
+// QueryRowContext builds the sql with the RETURNING columns,
+// executes it and returns the first returned row.
+func (b *UpdateBuilder) QueryRowContext(ctx context.Context) Row {
+	query, args := b.Build()
+	defer args.Release()
+
+	columns := returnedColumns(b.returnings)
+	return getDB(b.db).queryRow(ctx, defaultbinder, columns, query, args.Args()...)
+}
+
 // Exec builds the sql and executes it by *sql.DB.
 func (b *UpdateBuilder) Exec() (sql.Result, error) {
 	return b.ExecContext(context.Background())
@@ -190,6 +256,14 @@ func (b *UpdateBuilder) String() string {
 	return sql
 }
 
+// BuildWithDialect is the same as Build, but uses the dialect d instead of
+// the one of the db, which does not modify the db of the builder.
+func (b *UpdateBuilder) BuildWithDialect(d Dialect) (sql string, args *ArgsBuilder) {
+	nb := *b
+	nb.db = &DB{Dialect: d}
+	return nb.Build()
+}
+
 // Build builds the "UPDATE" sql statement.
 func (b *UpdateBuilder) Build() (sql string, args *ArgsBuilder) {
 	if len(b.utables) == 0 {
@@ -241,6 +315,13 @@ func (b *UpdateBuilder) Build() (sql string, args *ArgsBuilder) {
 	// Where
 	args = buildWheres(buf, args, dialect, b.wheres)
 
+	// Returning
+	if len(b.returnings) > 0 {
+		checkReturning(dialect, "UpdateBuilder")
+		buf.WriteString(" RETURNING ")
+		writeColumns(buf, dialect, b.returnings)
+	}
+
 	// Comment
 	if b.comment != "" {
 		buf.WriteString(" /* ")
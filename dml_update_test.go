@@ -15,7 +15,10 @@
 package sqlx
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"slices"
+	"testing"
 
 	"github.com/xgfone/go-op"
 )
@@ -47,3 +50,62 @@ func ExampleUpdateBuilder() {
 	// UPDATE "table" SET "c1"=$1, "c2"="c2"+1, "c3"="c3"-1 WHERE ("c4"=$2 AND "c5"<>$3 AND "c6" LIKE $4 AND "c7" NOT LIKE $5 AND "c8" BETWEEN $6 AND $7)
 	// [v1 v4 v5 %v6% v7% 11 22]
 }
+
+func TestUpdateBuilderReturning(t *testing.T) {
+	db, tdb := newTestDB(Postgres, func(string, []any) (testresult, error) {
+		return testresult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]driver.Value{{int64(1), "abc"}, {int64(2), "abc"}},
+		}, nil
+	})
+
+	var users []testUser
+	err := db.Update().Table("user").Set(op.Set("name", "abc")).
+		Where(op.Key("id").In([]int64{1, 2})).Returning("id", "name").
+		QueryRows().Bind(&users)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []testUser{{Id: 1, Name: "abc"}, {Id: 2, Name: "abc"}}
+	if !slices.Equal(expect, users) {
+		t.Errorf("expect users %v, but got %v", expect, users)
+	}
+
+	stmts := []string{`UPDATE "user" SET "name"=$1 WHERE "id" IN ($2, $3) RETURNING "id", "name"`}
+	if s := tdb.Statements(); !slices.Equal(stmts, s) {
+		t.Errorf("expect statements %q, but got %q", stmts, s)
+	}
+}
+
+func TestUpdateBuilderSetRaw(t *testing.T) {
+	sql, args := Update().Table("table").
+		Set(op.Add("c1", 11)).
+		SetRaw(`"c2"=some_func("c2", ?, ?)`, "a", "b").
+		Set(op.Set("c3", 33)).
+		Where(op.Equal("c4", 44)).
+		SetDB(&DB{Dialect: Postgres}).Build()
+
+	expectsql := `UPDATE "table" SET "c1"="c1"+$1, "c2"=some_func("c2", $2, $3), "c3"=$4 WHERE "c4"=$5`
+	if sql != expectsql {
+		t.Errorf(`expect sql "%s", but got "%s"`, expectsql, sql)
+	}
+
+	expectargs := []any{11, "a", "b", 33, 44}
+	if _args := args.Args(); !slices.Equal(expectargs, _args) {
+		t.Errorf("expect args %v, but got %v", expectargs, _args)
+	}
+	sql, args = Update().Table("table").
+		SetRaw(`"c?"=CASE WHEN "data"??'k' THEN '?' ELSE ? END`, "a").
+		SetDB(&DB{Dialect: Postgres}).Build()
+
+	expectsql = `UPDATE "table" SET "c?"=CASE WHEN "data"?'k' THEN '?' ELSE $1 END`
+	if sql != expectsql {
+		t.Errorf(`expect sql "%s", but got "%s"`, expectsql, sql)
+	}
+
+	expectargs = []any{"a"}
+	if _args := args.Args(); !slices.Equal(expectargs, _args) {
+		t.Errorf("expect args %v, but got %v", expectargs, _args)
+	}
+}
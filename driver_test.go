@@ -0,0 +1,208 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// testdriver is a fake sql driver, which records the executed statements
+// and returns the results produced by the handler of the test database.
+
+func init() { sql.Register("sqlxtest", testdriver{}) }
+
+type testresult struct {
+	Columns []string
+	Rows    [][]driver.Value
+
+	InsertId int64
+	Affected int64
+}
+
+type testhandler func(query string, args []any) (testresult, error)
+
+type testdatabase struct {
+	lock     sync.Mutex
+	stmts    []string
+	args     [][]any
+	handler  testhandler
+	prepares atomic.Int64 // The number of the prepared statements
+}
+
+func (db *testdatabase) Statements() []string {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	return append([]string(nil), db.stmts...)
+}
+
+func (db *testdatabase) Args() [][]any {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	return append([][]any(nil), db.args...)
+}
+
+func (db *testdatabase) handle(query string, args []driver.NamedValue) (testresult, error) {
+	_args := make([]any, len(args))
+	for i, arg := range args {
+		_args[i] = arg.Value
+	}
+
+	db.lock.Lock()
+	db.stmts = append(db.stmts, query)
+	db.args = append(db.args, _args)
+	handler := db.handler
+	db.lock.Unlock()
+
+	if handler == nil {
+		return testresult{}, nil
+	}
+	return handler(query, _args)
+}
+
+var (
+	testdbid  atomic.Int64
+	testdbmap sync.Map // dsn => *testdatabase
+)
+
+// newTestDB returns a new DB based on the fake driver and the database
+// to inspect the executed statements.
+func newTestDB(dialect Dialect, handler testhandler) (*DB, *testdatabase) {
+	tdb := &testdatabase{handler: handler}
+	dsn := fmt.Sprintf("testdb%d", testdbid.Add(1))
+	testdbmap.Store(dsn, tdb)
+
+	sqldb, err := sql.Open("sqlxtest", dsn)
+	if err != nil {
+		panic(err)
+	}
+	return &DB{Dialect: dialect, Executor: sqldb}, tdb
+}
+
+type testdriver struct{}
+
+func (testdriver) Open(dsn string) (driver.Conn, error) {
+	db, ok := testdbmap.Load(dsn)
+	if !ok {
+		return nil, fmt.Errorf("unknown test database '%s'", dsn)
+	}
+	return testconn{db: db.(*testdatabase)}, nil
+}
+
+type testconn struct{ db *testdatabase }
+
+var (
+	_ driver.ExecerContext  = testconn{}
+	_ driver.QueryerContext = testconn{}
+	_ driver.ConnBeginTx    = testconn{}
+)
+
+func (c testconn) Close() error { return nil }
+
+func (c testconn) Prepare(query string) (driver.Stmt, error) {
+	c.db.prepares.Add(1)
+	return teststmt{conn: c, query: query}, nil
+}
+
+func (c testconn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c testconn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.db.handle("BEGIN", nil); err != nil {
+		return nil, err
+	}
+	return testtx{conn: c}, nil
+}
+
+func (c testconn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := c.db.handle(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return testdriverresult(result), nil
+}
+
+func (c testconn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result, err := c.db.handle(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &testrows{result: result}, nil
+}
+
+type testtx struct{ conn testconn }
+
+func (tx testtx) Commit() error {
+	_, err := tx.conn.db.handle("COMMIT", nil)
+	return err
+}
+
+func (tx testtx) Rollback() error {
+	_, err := tx.conn.db.handle("ROLLBACK", nil)
+	return err
+}
+
+type teststmt struct {
+	conn  testconn
+	query string
+}
+
+func (s teststmt) Close() error  { return nil }
+func (s teststmt) NumInput() int { return -1 }
+
+func (s teststmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, tonamedvalues(args))
+}
+
+func (s teststmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, tonamedvalues(args))
+}
+
+func tonamedvalues(args []driver.Value) []driver.NamedValue {
+	values := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		values[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return values
+}
+
+type testdriverresult testresult
+
+func (r testdriverresult) LastInsertId() (int64, error) { return r.InsertId, nil }
+func (r testdriverresult) RowsAffected() (int64, error) { return r.Affected, nil }
+
+type testrows struct {
+	result testresult
+	index  int
+}
+
+func (r *testrows) Columns() []string { return r.result.Columns }
+func (r *testrows) Close() error      { return nil }
+
+func (r *testrows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.Rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.result.Rows[r.index])
+	r.index++
+	return nil
+}
@@ -48,6 +48,23 @@ func (is Interceptors) Intercept(sql string, args []any) (string, []any, error)
 	return sql, args, nil
 }
 
+// ChainInterceptors returns an interceptor that calls the interceptors
+// in order, which threads the rewritten sql and arguments through them
+// and stops on the first error. The nil interceptors are ignored.
+//
+// It can be set as DB.Interceptor to stack the interceptors, for example,
+//
+//	db.Interceptor = sqlx.ChainInterceptors(logging, metrics)
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	chain := make(Interceptors, 0, len(interceptors))
+	for _, i := range interceptors {
+		if i != nil {
+			chain = append(chain, i)
+		}
+	}
+	return chain
+}
+
 // DefaultSqlCollector is the default sql collector.
 var DefaultSqlCollector = NewSqlCollector()
 
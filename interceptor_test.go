@@ -15,6 +15,7 @@
 package sqlx
 
 import (
+	"errors"
 	"reflect"
 	"slices"
 	"strings"
@@ -49,3 +50,34 @@ func TestSqlCollector(t *testing.T) {
 		t.Errorf("expects %v, but got %v", excepts, sqls)
 	}
 }
+
+func TestChainInterceptors(t *testing.T) {
+	var calls []string
+	appendComment := func(name string) Interceptor {
+		return InterceptorFunc(func(sql string, args []any) (string, []any, error) {
+			calls = append(calls, name)
+			return sql + " /* " + name + " */", append(args, name), nil
+		})
+	}
+
+	errfail := errors.New("fail")
+	failure := InterceptorFunc(func(string, []any) (string, []any, error) { return "", nil, errfail })
+
+	chain := ChainInterceptors(appendComment("a"), nil, appendComment("b"))
+	sql, args, err := chain.Intercept("SELECT 1", nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if expect := "SELECT 1 /* a */ /* b */"; sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	} else if expect := []any{"a", "b"}; !slices.Equal(expect, args) {
+		t.Errorf("expect args %v, but got %v", expect, args)
+	}
+
+	calls = nil
+	chain = ChainInterceptors(appendComment("a"), failure, appendComment("b"))
+	if _, _, err = chain.Intercept("SELECT 1", nil); !errors.Is(err, errfail) {
+		t.Errorf("expect error '%v', but got '%v'", errfail, err)
+	} else if expect := []string{"a"}; !slices.Equal(expect, calls) {
+		t.Errorf("expect calls %v, but got %v", expect, calls)
+	}
+}
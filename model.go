@@ -44,14 +44,14 @@ type Base = Base1
 type Base1 struct {
 	Id int64 `sql:"id,omitempty" json:",omitempty,omitzero"`
 
-	CreatedAt time.Time `sql:"created_at,omitempty" json:",omitempty,omitzero"`
+	CreatedAt time.Time `sql:"created_at,omitempty,immutable" json:",omitempty,omitzero"`
 }
 
 // Base2 is the richer model columns of the sql table.
 type Base2 struct {
 	Id int64 `sql:"id,omitempty" json:",omitempty,omitzero"`
 
-	CreatedAt time.Time `sql:"created_at,omitempty" json:",omitempty,omitzero"`
+	CreatedAt time.Time `sql:"created_at,omitempty,immutable" json:",omitempty,omitzero"`
 	UpdatedAt time.Time `sql:"updated_at,omitempty" json:",omitempty,omitzero"`
 	DeletedAt time.Time `sql:"deleted_at,omitempty" json:",omitempty,omitzero"`
 }
@@ -133,6 +133,23 @@ func decodemap[M ~map[string]T, T any](m *M, src any) (err error) {
 	return
 }
 
+func decodejson(dst, src any) (err error) {
+	switch data := src.(type) {
+	case nil:
+	case []byte:
+		if data = bytes.TrimSpace(data); len(data) > 0 && !bytes.Equal(data, _jsonnull) {
+			err = json.Unmarshal(data, dst)
+		}
+	case string:
+		if data = strings.TrimSpace(data); data != "" && data != "null" {
+			err = json.Unmarshal([]byte(data), dst)
+		}
+	default:
+		err = fmt.Errorf("converting %T to %T is unsupported", src, dst)
+	}
+	return
+}
+
 func decodestrings[S ~[]string](s *S, src any, sep string) (err error) {
 	if sep == "" {
 		panic("sqlx.DecodeStrings: sep must not be empty")
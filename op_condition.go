@@ -81,8 +81,8 @@ func init() {
 	RegisterOpBuilder(op.CondOpLike, newCondLike("%s LIKE %s"))
 	RegisterOpBuilder(op.CondOpNotLike, newCondLike("%s NOT LIKE %s"))
 
-	RegisterOpBuilder(op.CondOpIn, newCondIn("%s IN (%s)"))
-	RegisterOpBuilder(op.CondOpNotIn, newCondIn("%s NOT IN (%s)"))
+	RegisterOpBuilder(op.CondOpIn, newCondIn("%s IN (%s)", "%s=%s"))
+	RegisterOpBuilder(op.CondOpNotIn, newCondIn("%s NOT IN (%s)", "%s<>%s"))
 
 	RegisterOpBuilder(op.CondOpBetween, newCondBetween("%s BETWEEN %s AND %s"))
 	RegisterOpBuilder(op.CondOpNotBetween, newCondBetween("%s NOT BETWEEN %s AND %s"))
@@ -128,8 +128,19 @@ func newCondLike(format string) OpBuilder {
 	})
 }
 
-func newCondIn(format string) OpBuilder {
+func newCondIn(inFormat, singleFormat string) OpBuilder {
 	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		// Expand the single slice argument wrapped by the variadic arguments,
+		// such as []any{[]int{1, 2, 3}}, instead of binding the whole slice.
+		if vs, ok := op.Val.([]any); ok && len(vs) == 1 && isExpandable(vs[0]) {
+			op.Val = vs[0]
+		}
+
+		format := inFormat
+		if ab.simplifyIn && isSingleton(op.Val) {
+			format = singleFormat
+		}
+
 		switch vs := op.Val.(type) {
 		case nil:
 			return "1=0"
@@ -234,6 +245,28 @@ func newCondIn(format string) OpBuilder {
 	})
 }
 
+// isExpandable reports whether v is a slice or array, except []byte
+// which is bound as a single value.
+func isExpandable(v any) bool {
+	switch vf := reflect.ValueOf(v); vf.Kind() {
+	case reflect.Array:
+		return true
+	case reflect.Slice:
+		return vf.Type().Elem().Kind() != reflect.Uint8
+	default:
+		return false
+	}
+}
+
+func isSingleton(v any) bool {
+	switch vf := reflect.ValueOf(v); vf.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return vf.Len() == 1
+	default:
+		return false
+	}
+}
+
 func fmtcondin_map[M ~map[K]V, K comparable, V bool | struct{}](format string, ab *ArgsBuilder, op op.Op, vs M) string {
 	switch _len := len(vs); _len {
 	case 0:
@@ -0,0 +1,72 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the containment
+// of the jsonb and array columns, which are only supported by Postgres.
+const (
+	CondOpContains    = "Contains"
+	CondOpContainedBy = "ContainedBy"
+	CondOpHasKey      = "HasKey"
+	CondOpOverlaps    = "Overlaps"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpContains, newCondPostgresOperator(CondOpContains, "%s @> %s"))
+	RegisterOpBuilder(CondOpContainedBy, newCondPostgresOperator(CondOpContainedBy, "%s <@ %s"))
+	RegisterOpBuilder(CondOpHasKey, newCondPostgresOperator(CondOpHasKey, "%s ? %s"))
+	RegisterOpBuilder(CondOpOverlaps, newCondPostgresOperator(CondOpOverlaps, "%s && %s"))
+}
+
+// Contains returns a condition that the jsonb or array column contains value,
+// which is built as "column @> $1" only for Postgres.
+//
+// value is bound as it is, such as a JSON string for jsonb or an array value.
+func Contains(column string, value any) op.Condition {
+	return op.New(CondOpContains, column, value).Condition()
+}
+
+// ContainedBy returns a condition that the jsonb or array column is contained
+// by value, which is built as "column <@ $1" only for Postgres.
+func ContainedBy(column string, value any) op.Condition {
+	return op.New(CondOpContainedBy, column, value).Condition()
+}
+
+// HasKey returns a condition that the jsonb column has the top-level key,
+// which is built as "column ? $1" only for Postgres.
+func HasKey(column string, key string) op.Condition {
+	return op.New(CondOpHasKey, column, key).Condition()
+}
+
+// Overlaps returns a condition that the array column has any element
+// in common with value, which is built as "column && $1" only for Postgres.
+func Overlaps(column string, value any) op.Condition {
+	return op.New(CondOpOverlaps, column, value).Condition()
+}
+
+func newCondPostgresOperator(cond, format string) OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		if name := ab.Name(); name != pqDialect {
+			panic(fmt.Errorf("sqlx: the dialect '%s' does not support the condition %s", name, cond))
+		}
+		return fmt.Sprintf(format, ab.Quote(getOpKey(op)), ab.Add(op.Val))
+	})
+}
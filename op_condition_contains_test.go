@@ -0,0 +1,54 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestContainsConditions(t *testing.T) {
+	tests := []struct {
+		cond  op.Condition
+		sql   string
+		value any
+	}{
+		{Contains("attrs", `{"color":"red"}`), `"attrs" @> $1`, `{"color":"red"}`},
+		{ContainedBy("tags", "{a,b}"), `"tags" <@ $1`, "{a,b}"},
+		{HasKey("attrs", "color"), `"attrs" ? $1`, "color"},
+		{Overlaps("tags", "{a,b}"), `"tags" && $1`, "{a,b}"},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(Postgres)
+		sql := BuildOper(ab, test.cond)
+		if sql != test.sql {
+			t.Errorf("expect sql '%s', but got '%s'", test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, []any{test.value}) {
+			t.Errorf("expect args %v, but got %v", []any{test.value}, args)
+		}
+		ab.Release()
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expect a panic, but got nil")
+		}
+	}()
+	BuildOper(GetArgsBuilderFromPool(MySQL), Contains("attrs", "{}"))
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/xgfone/go-op"
+)
+
+// ParseFilterJSON parses the JSON filter data to a condition tree, such as
+//
+//	{"and": [
+//	    {"field": "age", "op": "gte", "value": 18},
+//	    {"or": [
+//	        {"field": "name", "op": "like", "value": "abc%"},
+//	        {"field": "status", "op": "in", "value": [1, 2]}
+//	    ]}
+//	]}
+//
+// The supported operators are
+//
+//	eq, ne, lt, lte, gt, gte, like, notlike,
+//	in, notin, between, notbetween, isnull, notnull
+//
+// The value of in and notin must be a non-empty array, that of between
+// and notbetween must be an array with two elements, that of like and notlike
+// must be a string, and isnull and notnull need no value.
+//
+// Since the filter data generally comes from the untrusted user input,
+// the field not in allowedColumns is always rejected, so all the fields
+// are rejected if allowedColumns is empty.
+func ParseFilterJSON(data []byte, allowedColumns []string) (op.Condition, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var node filterNode
+	if err := dec.Decode(&node); err != nil {
+		return nil, fmt.Errorf("sqlx: invalid filter: %w", err)
+	}
+	return node.condition(allowedColumns)
+}
+
+type filterNode struct {
+	And []filterNode `json:"and"`
+	Or  []filterNode `json:"or"`
+
+	Field string `json:"field"`
+	Op    string `json:"op"`
+	Value any    `json:"value"`
+}
+
+func (n filterNode) condition(columns []string) (op.Condition, error) {
+	switch {
+	case n.And != nil && n.Or == nil && n.Field == "":
+		return n.group("and", n.And, columns, op.And)
+
+	case n.Or != nil && n.And == nil && n.Field == "":
+		return n.group("or", n.Or, columns, op.Or)
+
+	case n.Field != "" && n.And == nil && n.Or == nil:
+		return n.compare(columns)
+
+	default:
+		return nil, fmt.Errorf("sqlx: invalid filter: expect exactly one of and, or and field")
+	}
+}
+
+func (n filterNode) group(name string, nodes []filterNode, columns []string,
+	combine func(...op.Condition) op.Condition) (op.Condition, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("sqlx: invalid filter: %s must not be empty", name)
+	}
+
+	conds := make([]op.Condition, len(nodes))
+	for i, node := range nodes {
+		cond, err := node.condition(columns)
+		if err != nil {
+			return nil, err
+		}
+		conds[i] = cond
+	}
+	return combine(conds...), nil
+}
+
+func (n filterNode) compare(columns []string) (op.Condition, error) {
+	if !slices.Contains(columns, n.Field) {
+		return nil, fmt.Errorf("sqlx: invalid filter: the field '%s' is not allowed", n.Field)
+	}
+
+	switch n.Op {
+	case "isnull":
+		return op.IsNull(n.Field), nil
+	case "notnull":
+		return op.IsNotNull(n.Field), nil
+	}
+
+	value, err := filterValue(n.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case "eq":
+		return op.Equal(n.Field, value), nil
+	case "ne":
+		return op.NotEqual(n.Field, value), nil
+	case "lt":
+		return op.Less(n.Field, value), nil
+	case "lte":
+		return op.LessEqual(n.Field, value), nil
+	case "gt":
+		return op.Greater(n.Field, value), nil
+	case "gte":
+		return op.GreaterEqual(n.Field, value), nil
+
+	case "like", "notlike":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("sqlx: invalid filter: the value of %s must be a string", n.Op)
+		}
+		if n.Op == "like" {
+			return op.Like(n.Field, s), nil
+		}
+		return op.NotLike(n.Field, s), nil
+
+	case "in", "notin":
+		values, ok := value.([]any)
+		if !ok || len(values) == 0 {
+			return nil, fmt.Errorf("sqlx: invalid filter: the value of %s must be a non-empty array", n.Op)
+		}
+		if n.Op == "in" {
+			return op.In(n.Field, values), nil
+		}
+		return op.NotIn(n.Field, values), nil
+
+	case "between", "notbetween":
+		values, ok := value.([]any)
+		if !ok || len(values) != 2 {
+			return nil, fmt.Errorf("sqlx: invalid filter: the value of %s must be an array with two elements", n.Op)
+		}
+		if n.Op == "between" {
+			return op.Between(n.Field, values[0], values[1]), nil
+		}
+		return op.NotBetween(n.Field, values[0], values[1]), nil
+
+	default:
+		return nil, fmt.Errorf("sqlx: invalid filter: unsupported operator '%s'", n.Op)
+	}
+}
+
+// filterValue converts the json.Number to int64 or float64 recursively,
+// and rejects the object value.
+func filterValue(value any) (any, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+
+	case []any:
+		values := make([]any, len(v))
+		for i, e := range v {
+			if _, ok := e.([]any); ok {
+				return nil, fmt.Errorf("sqlx: invalid filter: the nested array value is unsupported")
+			}
+
+			var err error
+			if values[i], err = filterValue(e); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+
+	case map[string]any:
+		return nil, fmt.Errorf("sqlx: invalid filter: the object value is unsupported")
+
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestParseFilterJSON(t *testing.T) {
+	tests := []struct {
+		filter string
+		sql    string
+		args   []any
+	}{
+		{`{"field":"age","op":"eq","value":18}`, "`age`=?", []any{int64(18)}},
+		{`{"field":"age","op":"ne","value":1.5}`, "`age`<>?", []any{1.5}},
+		{`{"field":"age","op":"lt","value":18}`, "`age`<?", []any{int64(18)}},
+		{`{"field":"age","op":"lte","value":18}`, "`age`<=?", []any{int64(18)}},
+		{`{"field":"age","op":"gt","value":18}`, "`age`>?", []any{int64(18)}},
+		{`{"field":"age","op":"gte","value":18}`, "`age`>=?", []any{int64(18)}},
+		{`{"field":"name","op":"like","value":"a%"}`, "`name` LIKE ?", []any{"a%"}},
+		{`{"field":"name","op":"notlike","value":"a%"}`, "`name` NOT LIKE ?", []any{"a%"}},
+		{`{"field":"age","op":"in","value":[1,2]}`, "`age` IN (?, ?)", []any{int64(1), int64(2)}},
+		{`{"field":"age","op":"notin","value":[1,2]}`, "`age` NOT IN (?, ?)", []any{int64(1), int64(2)}},
+		{`{"field":"age","op":"between","value":[1,2]}`, "`age` BETWEEN ? AND ?", []any{int64(1), int64(2)}},
+		{`{"field":"age","op":"notbetween","value":[1,2]}`, "`age` NOT BETWEEN ? AND ?", []any{int64(1), int64(2)}},
+		{`{"field":"name","op":"isnull"}`, "`name` IS NULL", nil},
+		{`{"field":"name","op":"notnull"}`, "`name` IS NOT NULL", nil},
+
+		{
+			filter: `{"and":[{"field":"age","op":"gte","value":18},{"or":[{"field":"name","op":"eq","value":"a"},{"field":"name","op":"eq","value":"b"}]}]}`,
+			sql:    "(`age`>=? AND (`name`=? OR `name`=?))",
+			args:   []any{int64(18), "a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		cond, err := ParseFilterJSON([]byte(test.filter), []string{"age", "name"})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.filter, err)
+			continue
+		}
+
+		ab := GetArgsBuilderFromPool(MySQL)
+		if sql := BuildOper(ab, cond); sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.filter, test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(test.args, args) {
+			t.Errorf("%s: expect args %v, but got %v", test.filter, test.args, args)
+		}
+		ab.Release()
+	}
+}
+
+func TestParseFilterJSONError(t *testing.T) {
+	filters := []string{
+		`{"field":"password","op":"eq","value":"123"}`,
+		`{"and":[{"field":"age","op":"eq","value":1},{"field":"password","op":"eq","value":"123"}]}`,
+		`{"field":"age","op":"unknown","value":1}`,
+		`{"field":"age","op":"in","value":[]}`,
+		`{"field":"age","op":"between","value":[1]}`,
+		`{"field":"name","op":"like","value":1}`,
+		`{"field":"age","op":"eq","value":{"a":1}}`,
+		`{"and":[]}`,
+		`{"and":[{"field":"age","op":"eq","value":1}],"field":"age"}`,
+		`{}`,
+		`[]`,
+	}
+
+	for _, filter := range filters {
+		if _, err := ParseFilterJSON([]byte(filter), []string{"age", "name"}); err == nil {
+			t.Errorf("%s: expect an error, but got nil", filter)
+		}
+	}
+
+	if _, err := ParseFilterJSON([]byte(`{"field":"age","op":"eq","value":1}`), nil); err == nil {
+		t.Errorf("expect an error for the empty allowed columns, but got nil")
+	}
+}
+
+func ExampleParseFilterJSON() {
+	filter := `{"and":[{"field":"age","op":"gte","value":18},{"field":"status","op":"in","value":[1,2]}]}`
+	cond, err := ParseFilterJSON([]byte(filter), []string{"age", "status"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	sql, args := Select("*").From("user").Where(cond).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT * FROM `user` WHERE (`age`>=? AND `status` IN (?, ?))
+	// [18 1 2]
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about JSON.
+const (
+	CondOpJSONArrayHas = "JSONArrayHas"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpJSONArrayHas, newCondJSONArrayHas())
+}
+
+// JSONArrayHas returns a condition that the scalar value is one of
+// the elements of the JSON array column, which is built as
+//
+//	MySQL:    JSON_CONTAINS(column, CAST(? AS JSON))
+//	Postgres: ? = ANY(ARRAY(SELECT jsonb_array_elements_text(column)))
+//	SQLite3:  EXISTS (SELECT 1 FROM json_each(column) WHERE json_each.value=?)
+func JSONArrayHas(column string, value any) op.Condition {
+	return op.New(CondOpJSONArrayHas, column, value).Condition()
+}
+
+func newCondJSONArrayHas() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		column := ab.Quote(getOpKey(op))
+		switch name := ab.Name(); name {
+		case mysqlDialect:
+			return fmt.Sprintf("JSON_CONTAINS(%s, CAST(%s AS JSON))", column, ab.Add(mustEncodeJSON(op.Val)))
+
+		case pqDialect:
+			value, ok := op.Val.(string)
+			if !ok { // jsonb_array_elements_text returns the JSON text of the non-string element.
+				value = mustEncodeJSON(op.Val)
+			}
+			return fmt.Sprintf("%s = ANY(ARRAY(SELECT jsonb_array_elements_text(%s)))", ab.Add(value), column)
+
+		case sqlite3Dialect:
+			return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value=%s)", column, ab.Add(op.Val))
+
+		default:
+			panic(fmt.Errorf("sqlx: the dialect '%s' does not support the condition JSONArrayHas", name))
+		}
+	})
+}
+
+func mustEncodeJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Errorf("sqlx: fail to encode %T to JSON: %w", v, err))
+	}
+	return string(data)
+}
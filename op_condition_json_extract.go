@@ -0,0 +1,145 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about JSON.
+const (
+	CondOpJSONExtract = "JSONExtract"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpJSONExtract, newCondJSONExtract())
+}
+
+// JSONExtractExpr is the expression to extract the value from the JSON column
+// by the path, which can be used as the selected column by SelectExpr,
+// or compared by the conditions, such as Equal and In.
+type JSONExtractExpr struct {
+	column string
+	path   string
+}
+
+// JSONExtract returns an expression to extract the value from the JSON column
+// by the path, such as "$.name" or "$.tags[0]", the leading "$." of which
+// may be omitted. It is built as
+//
+//	MySQL:    JSON_EXTRACT(column, ?)    // path: "$.a.b[0]"
+//	Postgres: column #>> $1              // path: "{a,b,0}"
+//	SQLite3:  json_extract(column, ?)    // path: "$.a.b[0]"
+//
+// The path is bound as the argument.
+func JSONExtract(column, path string) JSONExtractExpr {
+	if column == "" {
+		panic("sqlx.JSONExtract: the column must not be empty")
+	}
+	return JSONExtractExpr{column: column, path: path}
+}
+
+// BuildExpr implements the interface Expr.
+func (e JSONExtractExpr) BuildExpr(ab *ArgsBuilder) string {
+	column := ab.Quote(e.column)
+	switch name := ab.Name(); name {
+	case mysqlDialect:
+		return fmt.Sprintf("JSON_EXTRACT(%s, %s)", column, ab.Add(e.mysqlPath()))
+
+	case pqDialect:
+		return fmt.Sprintf("%s #>> %s", column, ab.Add(e.pqPath()))
+
+	case sqlite3Dialect:
+		return fmt.Sprintf("json_extract(%s, %s)", column, ab.Add(e.mysqlPath()))
+
+	default:
+		panic(fmt.Errorf("sqlx: the dialect '%s' does not support JSONExtract", name))
+	}
+}
+
+func (e JSONExtractExpr) mysqlPath() string {
+	switch {
+	case strings.HasPrefix(e.path, "$"):
+		return e.path
+	case strings.HasPrefix(e.path, "["):
+		return "$" + e.path
+	default:
+		return "$." + e.path
+	}
+}
+
+func (e JSONExtractExpr) pqPath() string {
+	path := strings.TrimPrefix(e.path, "$")
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	keys := strings.FieldsFunc(path, func(r rune) bool { return r == '.' })
+	return "{" + strings.Join(keys, ",") + "}"
+}
+
+// Equal returns a condition "expr=value".
+func (e JSONExtractExpr) Equal(value any) op.Condition { return e.cond(op.Equal("", value)) }
+
+// NotEqual returns a condition "expr<>value".
+func (e JSONExtractExpr) NotEqual(value any) op.Condition { return e.cond(op.NotEqual("", value)) }
+
+// Less returns a condition "expr<value".
+func (e JSONExtractExpr) Less(value any) op.Condition { return e.cond(op.Less("", value)) }
+
+// LessEqual returns a condition "expr<=value".
+func (e JSONExtractExpr) LessEqual(value any) op.Condition { return e.cond(op.LessEqual("", value)) }
+
+// Greater returns a condition "expr>value".
+func (e JSONExtractExpr) Greater(value any) op.Condition { return e.cond(op.Greater("", value)) }
+
+// GreaterEqual returns a condition "expr>=value".
+func (e JSONExtractExpr) GreaterEqual(value any) op.Condition {
+	return e.cond(op.GreaterEqual("", value))
+}
+
+// In returns a condition "expr IN (values...)".
+func (e JSONExtractExpr) In(values ...any) op.Condition { return e.cond(op.In("", values)) }
+
+// NotIn returns a condition "expr NOT IN (values...)".
+func (e JSONExtractExpr) NotIn(values ...any) op.Condition { return e.cond(op.NotIn("", values)) }
+
+// IsNull returns a condition "expr IS NULL".
+func (e JSONExtractExpr) IsNull() op.Condition { return e.cond(op.IsNull("")) }
+
+// IsNotNull returns a condition "expr IS NOT NULL".
+func (e JSONExtractExpr) IsNotNull() op.Condition { return e.cond(op.IsNotNull("")) }
+
+type jsonExtractCond struct {
+	expr JSONExtractExpr
+	cond op.Op
+}
+
+func (e JSONExtractExpr) cond(c op.Condition) op.Condition {
+	return op.New(CondOpJSONExtract, e.column, jsonExtractCond{expr: e, cond: c.Op()}).Condition()
+}
+
+func newCondJSONExtract() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		c, ok := op.Val.(jsonExtractCond)
+		if !ok {
+			panic(fmt.Errorf("sqlx: the value of the condition JSONExtract must be built by JSONExtractExpr, but got %T", op.Val))
+		}
+
+		// The built expression contains the spaces, so it is not quoted again.
+		c.cond.Key = c.expr.BuildExpr(ab)
+		return BuildOp(ab, c.cond)
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestJSONExtract(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		cond    op.Condition
+		sql     string
+		args    []any
+	}{
+		{MySQL, JSONExtract("data", "$.name").Equal("abc"), "JSON_EXTRACT(`data`, ?)=?", []any{"$.name", "abc"}},
+		{MySQL, JSONExtract("data", "tags[0]").In("a", "b"), "JSON_EXTRACT(`data`, ?) IN (?, ?)", []any{"$.tags[0]", "a", "b"}},
+		{Postgres, JSONExtract("data", "$.user.name").Equal("abc"), `"data" #>> $1=$2`, []any{"{user,name}", "abc"}},
+		{Postgres, JSONExtract("data", "$.tags[0]").IsNull(), `"data" #>> $1 IS NULL`, []any{"{tags,0}"}},
+		{Sqlite3, JSONExtract("data", "$.age").Greater(18), `json_extract("data", ?)>?`, []any{"$.age", 18}},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, test.cond)
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, test.args) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), test.args, args)
+		}
+		ab.Release()
+	}
+}
+
+func ExampleJSONExtract() {
+	name := JSONExtract("data", "$.name")
+	sql, args := Select("id").SelectExpr(name, "name").From("user").Where(name.Equal("abc")).Build()
+
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT `id`, JSON_EXTRACT(`data`, ?) AS `name` FROM `user` WHERE JSON_EXTRACT(`data`, ?)=?
+	// [$.name $.name abc]
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestJSONArrayHas(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		value   any
+		sql     string
+		args    []any
+	}{
+		{MySQL, "abc", "JSON_CONTAINS(`tags`, CAST(? AS JSON))", []any{`"abc"`}},
+		{MySQL, 123, "JSON_CONTAINS(`tags`, CAST(? AS JSON))", []any{`123`}},
+		{Postgres, "abc", `$1 = ANY(ARRAY(SELECT jsonb_array_elements_text("tags")))`, []any{"abc"}},
+		{Postgres, 123, `$1 = ANY(ARRAY(SELECT jsonb_array_elements_text("tags")))`, []any{"123"}},
+		{Sqlite3, "abc", `EXISTS (SELECT 1 FROM json_each("tags") WHERE json_each.value=?)`, []any{"abc"}},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, JSONArrayHas("tags", test.value))
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, test.args) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), test.args, args)
+		}
+		ab.Release()
+	}
+}
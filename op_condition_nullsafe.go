@@ -0,0 +1,61 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the null-safe comparison.
+const (
+	CondOpNullSafeEqual = "NullSafeEqual"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpNullSafeEqual, newCondNullSafeEqual())
+}
+
+// NullSafeEqual returns a null-safe equality condition, which is true
+// if both the column and the value are NULL, and built as
+//
+//	MySQL:     column <=> ?
+//	Postgres:  column IS NOT DISTINCT FROM $1
+//	SQLServer: column IS NOT DISTINCT FROM @p1
+//	SQLite3:   column IS ?
+//
+// Different from op.Equal, the nil value is bound as NULL instead of
+// being ignored.
+func NullSafeEqual(column string, value any) op.Condition {
+	return op.New(CondOpNullSafeEqual, column, value).Condition()
+}
+
+func newCondNullSafeEqual() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		var format string
+		switch name := ab.Name(); name {
+		case mysqlDialect:
+			format = "%s<=>%s"
+		case pqDialect, sqlserverDialect:
+			format = "%s IS NOT DISTINCT FROM %s"
+		case sqlite3Dialect:
+			format = "%s IS %s"
+		default:
+			panic(fmt.Errorf("sqlx: the dialect '%s' does not support the condition NullSafeEqual", name))
+		}
+		return fmt.Sprintf(format, ab.Quote(getOpKey(op)), ab.Add(op.Val))
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNullSafeEqual(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		value   any
+		sql     string
+	}{
+		{MySQL, 1, "`parent_id`<=>?"},
+		{MySQL, nil, "`parent_id`<=>?"},
+		{Postgres, 1, `"parent_id" IS NOT DISTINCT FROM $1`},
+		{SQLServer, 1, "[parent_id] IS NOT DISTINCT FROM @p1"},
+		{Sqlite3, nil, `"parent_id" IS ?`},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, NullSafeEqual("parent_id", test.value))
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, []any{test.value}) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), []any{test.value}, args)
+		}
+		ab.Release()
+	}
+}
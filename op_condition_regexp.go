@@ -0,0 +1,91 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the regular expression.
+const (
+	CondOpRegexp    = "Regexp"
+	CondOpNotRegexp = "NotRegexp"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpRegexp, newCondRegexp(false))
+	RegisterOpBuilder(CondOpNotRegexp, newCondRegexp(true))
+}
+
+// Regexp returns a condition that the column matches the regular expression
+// pattern, which is passed as the bound argument and built as
+//
+//	MySQL, SQLite3: column REGEXP ?
+//	Postgres:       column ~ $1
+//	ClickHouse:     match(column, ?)
+//	Oracle:         REGEXP_LIKE(column, :1)
+//
+// Notice: SQLite3 requires the user-defined function regexp().
+func Regexp(column, pattern string) op.Condition {
+	return op.New(CondOpRegexp, column, pattern).Condition()
+}
+
+// NotRegexp is the inverse of Regexp, which is built as
+//
+//	MySQL, SQLite3: column NOT REGEXP ?
+//	Postgres:       column !~ $1
+//	ClickHouse:     NOT match(column, ?)
+//	Oracle:         NOT REGEXP_LIKE(column, :1)
+func NotRegexp(column, pattern string) op.Condition {
+	return op.New(CondOpNotRegexp, column, pattern).Condition()
+}
+
+func newCondRegexp(not bool) OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		var format string
+		switch name := ab.Name(); name {
+		case mysqlDialect, sqlite3Dialect:
+			format = "%s REGEXP %s"
+			if not {
+				format = "%s NOT REGEXP %s"
+			}
+
+		case pqDialect:
+			format = "%s ~ %s"
+			if not {
+				format = "%s !~ %s"
+			}
+
+		case clickhouseDialect:
+			format = "match(%s, %s)"
+			if not {
+				format = "NOT match(%s, %s)"
+			}
+
+		case oracleDialect:
+			format = "REGEXP_LIKE(%s, %s)"
+			if not {
+				format = "NOT REGEXP_LIKE(%s, %s)"
+			}
+
+		default:
+			panic(fmt.Errorf("sqlx: the dialect '%s' does not support the condition %s", name, op.Op))
+		}
+
+		return fmt.Sprintf(format, ab.Quote(getOpKey(op)), ab.Add(op.Val))
+	})
+}
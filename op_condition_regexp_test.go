@@ -0,0 +1,50 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestRegexp(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		cond    op.Condition
+		sql     string
+	}{
+		{MySQL, Regexp("name", "^a.*"), "`name` REGEXP ?"},
+		{MySQL, NotRegexp("name", "^a.*"), "`name` NOT REGEXP ?"},
+		{Postgres, Regexp("name", "^a.*"), `"name" ~ $1`},
+		{Postgres, NotRegexp("name", "^a.*"), `"name" !~ $1`},
+		{ClickHouse, Regexp("name", "^a.*"), "match(`name`, ?)"},
+		{Oracle, NotRegexp("name", "^a.*"), `NOT REGEXP_LIKE("name", :1)`},
+		{Postgres, op.New(CondOpRegexp, "name", "^a.*").Condition(), `"name" ~ $1`},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, test.cond)
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, []any{"^a.*"}) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), []any{"^a.*"}, args)
+		}
+		ab.Release()
+	}
+}
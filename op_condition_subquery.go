@@ -0,0 +1,128 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the subquery.
+const (
+	CondOpInSubquery    = "InSubquery"
+	CondOpNotInSubquery = "NotInSubquery"
+
+	CondOpScalarSubquery = "ScalarSubquery"
+
+	CondOpExists    = "Exists"
+	CondOpNotExists = "NotExists"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpInSubquery, newCondSubquery("%s IN (%s)"))
+	RegisterOpBuilder(CondOpNotInSubquery, newCondSubquery("%s NOT IN (%s)"))
+	RegisterOpBuilder(CondOpScalarSubquery, OpBuilderFunc(buildScalarSubquery))
+	RegisterOpBuilder(CondOpExists, newCondExists("EXISTS (%s)"))
+	RegisterOpBuilder(CondOpNotExists, newCondExists("NOT EXISTS (%s)"))
+}
+
+// InSubquery returns a condition "column IN (SELECT ...)",
+// the arguments of which are appended in order.
+func InSubquery(column string, sub *SelectBuilder) op.Condition {
+	return op.New(CondOpInSubquery, column, sub).Condition()
+}
+
+// NotInSubquery returns a condition "column NOT IN (SELECT ...)",
+// the arguments of which are appended in order.
+func NotInSubquery(column string, sub *SelectBuilder) op.Condition {
+	return op.New(CondOpNotInSubquery, column, sub).Condition()
+}
+
+// Exists returns a condition "EXISTS (SELECT ...)" for the semi-join,
+// the arguments of which are appended in order.
+//
+// The subquery may reference the columns of the outer query,
+// such as op.EqualKey("o.user_id", "u.id"), which are quoted as usual.
+func Exists(sub *SelectBuilder) op.Condition {
+	return op.New(CondOpExists, "", sub).Condition()
+}
+
+// NotExists returns a condition "NOT EXISTS (SELECT ...)" for the anti-join,
+// the arguments of which are appended in order.
+func NotExists(sub *SelectBuilder) op.Condition {
+	return op.New(CondOpNotExists, "", sub).Condition()
+}
+
+// ColScalarSubquery returns a condition "column op (SELECT ...)" that compares
+// the column with the scalar subquery, the arguments of which are appended
+// in order, such as
+//
+//	ColScalarSubquery("price", ">", Select("AVG(price)").From("products"))
+//	// => price > (SELECT AVG(price) FROM products)
+//
+// operator must be one of "=", "<>", "!=", "<", "<=", ">" and ">=",
+// and the subquery must select exactly one column.
+func ColScalarSubquery(column, operator string, sub *SelectBuilder) op.Condition {
+	switch operator {
+	case "=", "<>", "!=", "<", "<=", ">", ">=":
+	default:
+		panic(fmt.Errorf("sqlx.ColScalarSubquery: unsupported operator '%s'", operator))
+	}
+
+	if sub == nil {
+		panic("sqlx.ColScalarSubquery: the subquery must not be nil")
+	} else if len(sub.columns) != 1 || sub.columns[0].Column == "*" {
+		panic("sqlx.ColScalarSubquery: the subquery must select exactly one column")
+	}
+
+	return op.New(CondOpScalarSubquery, column, scalarSubquery{operator: operator, sub: sub}).Condition()
+}
+
+type scalarSubquery struct {
+	operator string
+	sub      *SelectBuilder
+}
+
+func buildScalarSubquery(ab *ArgsBuilder, _op op.Op) string {
+	v := _op.Val.(scalarSubquery)
+	return fmt.Sprintf("%s %s (%s)", ab.Quote(getOpKey(_op)), v.operator, buildSubquery(ab, v.sub))
+}
+
+func newCondSubquery(format string) OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		return fmt.Sprintf(format, ab.Quote(getOpKey(op)), buildSubquery(ab, op.Val))
+	})
+}
+
+func newCondExists(format string) OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		return fmt.Sprintf(format, buildSubquery(ab, op.Val))
+	})
+}
+
+// buildSubquery builds the subquery without the comment,
+// and appends its arguments into ab.
+func buildSubquery(ab *ArgsBuilder, sub any) string {
+	query, ok := sub.(*SelectBuilder)
+	if !ok || query == nil {
+		panic(fmt.Errorf("sqlx: the subquery must be a *SelectBuilder, but got %T", sub))
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	query.build(buf, ab, ab.Dialect)
+	return buf.String()
+}
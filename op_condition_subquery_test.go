@@ -0,0 +1,88 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func ExampleInSubquery() {
+	db := &DB{Dialect: Postgres}
+	active := db.Select("id").From("users").Where(op.Equal("active", 1))
+	banned := db.Select("user_id").From("banned").Where(op.Equal("reason", "spam"))
+
+	sql, args := db.Select("*").From("orders").Where(
+		op.Greater("amount", 100),
+		InSubquery("user_id", active),
+		NotInSubquery("user_id", banned),
+	).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT * FROM "orders" WHERE ("amount">$1 AND "user_id" IN (SELECT "id" FROM "users" WHERE "active"=$2) AND "user_id" NOT IN (SELECT "user_id" FROM "banned" WHERE "reason"=$3))
+	// [100 1 spam]
+}
+
+func ExampleExists() {
+	db := &DB{Dialect: Postgres}
+	paid := db.Select("1").FromAlias("orders", "o").Where(op.EqualKey("o.user_id", "u.id"), op.Equal("o.status", "paid"))
+	banned := db.Select("1").FromAlias("banned", "b").Where(op.EqualKey("b.user_id", "u.id"))
+
+	sql, args := db.Select("u.id").FromAlias("users", "u").Where(
+		op.Equal("u.active", 1),
+		Exists(paid),
+		NotExists(banned),
+	).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT "u"."id" FROM "users" AS "u" WHERE ("u"."active"=$1 AND EXISTS (SELECT 1 FROM "orders" AS "o" WHERE ("o"."user_id"="u"."id" AND "o"."status"=$2)) AND NOT EXISTS (SELECT 1 FROM "banned" AS "b" WHERE "b"."user_id"="u"."id"))
+	// [1 paid]
+}
+
+func ExampleColScalarSubquery() {
+	db := &DB{Dialect: Postgres}
+	avg := db.Select("AVG(price)").From("products").Where(op.Equal("category", "book"))
+
+	sql, args := db.Select("id").From("products").Where(
+		op.Equal("category", "book"),
+		ColScalarSubquery("price", ">", avg),
+		op.Less("stock", 10),
+	).Build()
+	fmt.Println(sql)
+	fmt.Println(args.Args())
+
+	// Output:
+	// SELECT "id" FROM "products" WHERE ("category"=$1 AND "price" > (SELECT AVG("price") FROM "products" WHERE "category"=$2) AND "stock"<$3)
+	// [book book 10]
+}
+
+func TestColScalarSubqueryPanic(t *testing.T) {
+	for _, sub := range []*SelectBuilder{Select("*").From("t"), Selects("a", "b").From("t")} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expect a panic for the subquery '%s', but got nil", sub.String())
+				}
+			}()
+			ColScalarSubquery("price", ">", sub)
+		}()
+	}
+}
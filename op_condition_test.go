@@ -101,6 +101,32 @@ func TestCondInForOne(t *testing.T) {
 	}
 }
 
+func TestCondInForSingleSlice(t *testing.T) {
+	tests := []struct {
+		cond op.Condition
+		sql  string
+		args []any
+	}{
+		{op.In("id", []int{1, 2, 3}), "`id` IN (?, ?, ?)", []any{1, 2, 3}},
+		{op.In("id", []any{[]int{1, 2, 3}}), "`id` IN (?, ?, ?)", []any{1, 2, 3}},
+		{op.NotIn("id", []any{[]int64{1, 2}}), "`id` NOT IN (?, ?)", []any{int64(1), int64(2)}},
+		{op.In("data", []any{[]byte("ab")}), "`data` IN (?)", []any{[]byte("ab")}},
+	}
+
+	for i, test := range tests {
+		ab := GetArgsBuilderFromPool(MySQL)
+		sql := BuildOper(ab, test.cond)
+		args := ab.Args()
+
+		if sql != test.sql {
+			t.Errorf("%d: expect sql '%s', but got '%s'", i, test.sql, sql)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d: expect args %v, but got %v", i, test.args, args)
+		}
+	}
+}
+
 func TestCondInForMapNil(t *testing.T) {
 	ab := GetArgsBuilderFromPool(MySQL)
 	sql := BuildOper(ab, op.Key("field").In(map[string]struct{}(nil)))
@@ -0,0 +1,63 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the date arithmetic.
+const (
+	CondOpRecentWithin = "RecentWithin"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpRecentWithin, newCondRecentWithin())
+}
+
+// RecentWithin returns a condition that the time column is within
+// the recent duration d, which is built as
+//
+//	MySQL:      column > NOW() - INTERVAL 7 DAY
+//	Postgres:   column > NOW() - INTERVAL '7 days'
+//	ClickHouse: column > now() - INTERVAL 7 DAY
+//	Oracle:     column > SYSTIMESTAMP - NUMTODSINTERVAL(7, 'DAY')
+//
+// The interval literal is rendered by Dialect.IntervalExpr.
+func RecentWithin(column string, d time.Duration) op.Condition {
+	return op.New(CondOpRecentWithin, column, d).Condition()
+}
+
+func newCondRecentWithin() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, op op.Op) string {
+		var now string
+		switch name := ab.Name(); name {
+		case mysqlDialect, pqDialect:
+			now = "NOW()"
+		case clickhouseDialect:
+			now = "now()"
+		case oracleDialect:
+			now = "SYSTIMESTAMP"
+		default:
+			panic(fmt.Errorf("sqlx: the dialect '%s' does not support the condition RecentWithin", name))
+		}
+
+		interval := ab.IntervalExpr(op.Val.(time.Duration))
+		return fmt.Sprintf("%s > %s - %s", ab.Quote(getOpKey(op)), now, interval)
+	})
+}
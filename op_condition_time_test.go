@@ -0,0 +1,58 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecentWithin(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		duration time.Duration
+		sql      string
+	}{
+		{MySQL, time.Hour * 24 * 7, "`created_at` > NOW() - INTERVAL 7 DAY"},
+		{MySQL, time.Minute * 90, "`created_at` > NOW() - INTERVAL 90 MINUTE"},
+		{Postgres, time.Hour * 24 * 7, `"created_at" > NOW() - INTERVAL '7 days'`},
+		{Postgres, time.Second * 30, `"created_at" > NOW() - INTERVAL '30 seconds'`},
+		{ClickHouse, time.Hour * 2, "`created_at` > now() - INTERVAL 2 HOUR"},
+		{Oracle, time.Hour * 24 * 7, `"created_at" > SYSTIMESTAMP - NUMTODSINTERVAL(7, 'DAY')`},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, RecentWithin("created_at", test.duration))
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); len(args) != 0 {
+			t.Errorf("%s: expect no args, but got %v", test.dialect.Name(), args)
+		}
+		ab.Release()
+	}
+
+	for _, d := range []Dialect{Sqlite3, SQLServer} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expect a panic, but got not", d.Name())
+				}
+			}()
+			BuildOper(GetArgsBuilderFromPool(d), RecentWithin("created_at", time.Hour))
+		}()
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xgfone/go-op"
+)
+
+// Predefine some condition operations about the tuple.
+const (
+	CondOpTupleIn = "TupleIn"
+)
+
+func init() {
+	RegisterOpBuilder(CondOpTupleIn, newCondTupleIn())
+}
+
+type tupleIn struct {
+	Columns []string
+	Rows    [][]any
+}
+
+// TupleIn returns a condition to check whether the tuple of the columns
+// is in rows, which is used to look up by the composite key and built as
+//
+//	(a, b) IN ((?, ?), (?, ?))
+//
+// The values are bound in row-major order, and each row must have
+// the same number of values as columns. If rows is empty, it is built as "1=0".
+func TupleIn(columns []string, rows [][]any) op.Condition {
+	if len(columns) == 0 {
+		panic("sqlx.TupleIn: no columns")
+	}
+
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			panic(fmt.Errorf("sqlx.TupleIn: the %dth row has %d values, but expect %d", i, len(row), len(columns)))
+		}
+	}
+
+	return op.New(CondOpTupleIn, "", tupleIn{Columns: columns, Rows: rows}).Condition()
+}
+
+func newCondTupleIn() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, _op op.Op) string {
+		tuple := _op.Val.(tupleIn)
+		if len(tuple.Rows) == 0 {
+			return "1=0"
+		}
+
+		var b strings.Builder
+		b.WriteByte('(')
+		for i, column := range tuple.Columns {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(ab.Quote(column))
+		}
+		b.WriteString(") IN (")
+
+		for i, row := range tuple.Rows {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+
+			b.WriteByte('(')
+			for j, value := range row {
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(ab.Add(value))
+			}
+			b.WriteByte(')')
+		}
+
+		b.WriteByte(')')
+		return b.String()
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTupleIn(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		rows    [][]any
+		sql     string
+		args    []any
+	}{
+		{MySQL, [][]any{{1, 2}, {3, 4}}, "(`a`, `b`) IN ((?, ?), (?, ?))", []any{1, 2, 3, 4}},
+		{Postgres, [][]any{{1, "x"}}, `("a", "b") IN (($1, $2))`, []any{1, "x"}},
+		{MySQL, nil, "1=0", []any{}},
+	}
+
+	for _, test := range tests {
+		ab := GetArgsBuilderFromPool(test.dialect)
+		sql := BuildOper(ab, TupleIn([]string{"a", "b"}, test.rows))
+		if sql != test.sql {
+			t.Errorf("%s: expect sql '%s', but got '%s'", test.dialect.Name(), test.sql, sql)
+		}
+		if args := ab.Args(); !slices.Equal(args, test.args) {
+			t.Errorf("%s: expect args %v, but got %v", test.dialect.Name(), test.args, args)
+		}
+		ab.Release()
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expect a panic, but got nil")
+		}
+	}()
+	TupleIn([]string{"a", "b"}, [][]any{{1}})
+}
@@ -21,7 +21,12 @@ import (
 	"github.com/xgfone/go-op"
 )
 
+// UpdateOpRaw is the operation of the raw "SET" fragment,
+// whose value is built by UpdateBuilder.SetRaw.
+const UpdateOpRaw = "Raw"
+
 func init() {
+	RegisterOpBuilder(UpdateOpRaw, newUpdaterRaw())
 	RegisterOpBuilder(op.UpdateOpBatch, newUpdaterBatch())
 	RegisterOpBuilder(op.UpdateOpSet, newUpdaterSet())
 	RegisterOpBuilder(op.UpdateOpInc, newUpdaterTwo("%s=%s+1"))
@@ -104,3 +109,51 @@ func newUpdaterThree(format string) OpBuilder {
 		return fmt.Sprintf(format, left, right, value)
 	})
 }
+
+type rawUpdater struct {
+	Expr string
+	Args []any
+}
+
+func newUpdaterRaw() OpBuilder {
+	return OpBuilderFunc(func(ab *ArgsBuilder, _op op.Op) string {
+		raw, ok := _op.Val.(rawUpdater)
+		if !ok {
+			panic(fmt.Errorf("sqlx: unsupported value type %T for op '%s:%v'", _op.Val, _op.Kind, _op.Op))
+		}
+
+		var b strings.Builder
+		b.Grow(len(raw.Expr) + len(raw.Args)*2)
+
+		var n int
+		expr := raw.Expr
+		for i, _len := 0, len(expr); i < _len; {
+			switch c := expr[i]; {
+			case c == '\'' || c == '"':
+				end := skipQuotedLiteral(expr, i)
+				b.WriteString(expr[i:end])
+				i = end
+
+			case c == '?' && i+1 < _len && expr[i+1] == '?':
+				b.WriteByte('?')
+				i += 2
+
+			case c == '?':
+				if n < len(raw.Args) {
+					b.WriteString(ab.Add(raw.Args[n]))
+				}
+				n++
+				i++
+
+			default:
+				b.WriteByte(c)
+				i++
+			}
+		}
+
+		if n != len(raw.Args) {
+			panic(fmt.Errorf("sqlx: the raw updater '%s' expects %d args, but got %d", raw.Expr, n, len(raw.Args)))
+		}
+		return b.String()
+	})
+}
@@ -15,8 +15,11 @@
 package sqlx
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -26,6 +29,11 @@ import (
 // GeneralScanner is a general sql.Scanner.
 type GeneralScanner struct {
 	Value any
+
+	// Strict makes the integer or float column be scanned into *bool
+	// only if it is 0 or 1, or returns an error, which is used to avoid
+	// scanning a multi-valued TINYINT column into a bool silently.
+	Strict bool
 }
 
 // Scan implements the interface sql.Scanner to scan the sql column src into the wrapped Value,
@@ -37,11 +45,24 @@ type GeneralScanner struct {
 //
 //	nil: ignore the column value src
 //	*any: put src as it is into the wrapped value
+//	*struct, *map, *slice: json.Unmarshal(src) only for []byte or string
 //	*time.Duration:
 //	    string:    time.ParseDuration(src)
 //	    []byte:    time.ParseDuration(string(src))
 //	    int64:     time.Duration(src) * time.Millisecond
 //	    float64:   time.Duration(src  * float64(time.Second))
+//	*NullableTime:
+//	    nil:       Valid=false
+//	    others:    the same as *time.Time, and Valid=true
+//	*NullTime:
+//	    nil:       Valid=false
+//	    others:    the same as *time.Time, and Valid=false if ZERO
+//	*Decimal:
+//	    nil:       ""
+//	    string:    src if it is a valid decimal
+//	    []byte:    string(src) if it is a valid decimal
+//	    int64:     strconv.FormatInt(src, 10)
+//	    float64:   strconv.FormatFloat(src, 'f', -1, 64)
 //	*time.Time:
 //	    int64:     time.Unix(src, 0).In(Location)
 //	    float64:   time.Unix(Integer, Fraction).In(Location)
@@ -50,12 +71,17 @@ type GeneralScanner struct {
 //	    time.Time: src
 //	*bool:
 //	     bool:     src
-//	     int64:    src!=0
-//	     float64:  src!=0
+//	     int64:    src!=0 (only 0 or 1 if Strict)
+//	     float64:  src!=0 (only 0 or 1 if Strict)
 //	     string:   strconv.ParseBool(src)
 //	     []byte:
 //	               len(src)==1: src[0] != '\x00'
 //	               len(src)!=1: strconv.ParseBool(string(src))
+//	*[]byte, *json.RawMessage:
+//	    nil:       nil, like database/sql
+//	    []byte:    bytes.Clone(src)
+//	    string:    []byte(src)
+//	    others:    []byte(str), and str is scanned from src like *string
 //	*string:
 //	    string:    src
 //	    []byte:    string(src)
@@ -84,6 +110,27 @@ type GeneralScanner struct {
 //	    []byte:    strconv.ParseUint(string(src), 10, 64)
 //	    time.Time: src.Unix() only for uint/uint64
 func (s GeneralScanner) Scan(src any) (err error) {
+	switch v := s.Value.(type) {
+	case *NullableTime:
+		return v.Scan(src)
+	case *NullTime:
+		return v.Scan(src)
+	case *Decimal:
+		return v.Scan(src)
+
+	case *[]byte:
+		if src == nil {
+			*v = nil
+			return
+		}
+
+	case *json.RawMessage:
+		if src == nil {
+			*v = nil
+			return
+		}
+	}
+
 	if src == nil {
 		return
 	}
@@ -114,23 +161,23 @@ func (s GeneralScanner) Scan(src any) (err error) {
 		*v, err = toTime(src, defaults.TimeLocation.Get())
 
 	case *bool:
-		switch s := src.(type) {
+		switch src := src.(type) {
 		case int64:
-			*v = s != 0
+			*v, err = s.toBool(src, src != 0, src != 0 && src != 1)
 		case float32:
-			*v = s != 0
+			*v, err = s.toBool(src, src != 0, src != 0 && src != 1)
 		case float64:
-			*v = s != 0
+			*v, err = s.toBool(src, src != 0, src != 0 && src != 1)
 		case bool:
-			*v = s
+			*v = src
 		case []byte:
-			if len(s) == 1 {
-				*v = s[0] != '\x00'
+			if len(src) == 1 {
+				*v = src[0] != '\x00'
 			} else {
-				*v, err = strconv.ParseBool(string(s))
+				*v, err = strconv.ParseBool(string(src))
 			}
 		case string:
-			*v, err = strconv.ParseBool(s)
+			*v, err = strconv.ParseBool(src)
 		default:
 			err = fmt.Errorf("converting %T to bool is unsupported", src)
 		}
@@ -568,6 +615,12 @@ func (s GeneralScanner) Scan(src any) (err error) {
 			err = fmt.Errorf("converting %T to string is unsupported", src)
 		}
 
+	case *[]byte:
+		*v, err = toBytes(src)
+
+	case *json.RawMessage:
+		*v, err = toBytes(src)
+
 	case *any:
 		*v = src
 
@@ -575,12 +628,51 @@ func (s GeneralScanner) Scan(src any) (err error) {
 		// ignore the column value
 
 	default:
-		panic(fmt.Errorf("sqlx.GeneralScanner.Scan: unsupported type '%T'", s.Value))
+		if !isJSONDecodable(s.Value) {
+			panic(fmt.Errorf("sqlx.GeneralScanner.Scan: unsupported type '%T'", s.Value))
+		}
+		err = decodejson(s.Value, src)
 	}
 
 	return
 }
 
+// isJSONDecodable reports whether v is a pointer to struct, map or slice,
+// except time.Time, which is decoded from the JSON column.
+func isJSONDecodable(v any) bool {
+	vtype := reflect.TypeOf(v)
+	if vtype == nil || vtype.Kind() != reflect.Pointer {
+		return false
+	}
+
+	switch vtype = vtype.Elem(); vtype.Kind() {
+	case reflect.Struct:
+		return vtype != _timetype
+	case reflect.Map, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+// toBytes copies src as bytes, which does not retain the buffer of the driver.
+func toBytes(src any) ([]byte, error) {
+	switch s := src.(type) {
+	case []byte:
+		return bytes.Clone(s), nil
+
+	case string:
+		return []byte(s), nil
+
+	default:
+		var str string
+		if err := (GeneralScanner{Value: &str}).Scan(src); err != nil {
+			return nil, fmt.Errorf("converting %T to []byte is unsupported", src)
+		}
+		return []byte(str), nil
+	}
+}
+
 func toTime(src any, loc *time.Location) (time.Time, error) {
 	switch s := src.(type) {
 	case string:
@@ -629,3 +721,10 @@ func parseTimeBytes(b []byte, loc *time.Location) (t time.Time, err error) {
 	}
 	return
 }
+
+func (s GeneralScanner) toBool(src any, value, invalid bool) (bool, error) {
+	if s.Strict && invalid {
+		return false, fmt.Errorf("converting %T %v to bool is unsupported in strict mode", src, src)
+	}
+	return value, nil
+}
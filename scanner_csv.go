@@ -0,0 +1,68 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql"
+	"strings"
+)
+
+var _ sql.Scanner = CSVScanner{}
+
+// CSVStrings returns a sql.Scanner to split the string column value
+// by sep into dst, which keeps the empty elements.
+func CSVStrings(sep string, dst *[]string) sql.Scanner {
+	return CSVScanner{Sep: sep, Dst: dst}
+}
+
+// CSVScanner is a sql.Scanner to split the string column value by Sep into Dst,
+// which is a lightweight alternative to scan a column like "a,b,c".
+type CSVScanner struct {
+	Dst *[]string
+	Sep string
+
+	// If true, the empty elements after trimming the spaces are skipped.
+	SkipEmpty bool
+}
+
+// Scan implements the interface sql.Scanner.
+//
+// If the column value is NULL or empty, Dst is set to nil.
+func (s CSVScanner) Scan(src any) error {
+	if s.Sep == "" {
+		panic("sqlx.CSVScanner: sep must not be empty")
+	}
+
+	var values []string
+	if err := DecodeStrings(&values, src, s.Sep); err != nil {
+		return err
+	}
+
+	if s.SkipEmpty {
+		vs := values[:0]
+		for _, v := range values {
+			if v = strings.TrimSpace(v); v != "" {
+				vs = append(vs, v)
+			}
+		}
+
+		if values = vs; len(values) == 0 {
+			values = nil
+		}
+	}
+
+	*s.Dst = values
+	return nil
+}
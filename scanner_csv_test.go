@@ -0,0 +1,57 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCSVStrings(t *testing.T) {
+	tests := []struct {
+		src       any
+		skipEmpty bool
+		expect    []string
+	}{
+		{nil, false, nil},
+		{"", false, nil},
+		{[]byte("  "), false, nil},
+		{"a,b,c", false, []string{"a", "b", "c"}},
+		{[]byte("a,,c"), false, []string{"a", "", "c"}},
+		{"a, ,c,", true, []string{"a", "c"}},
+		{",,", true, nil},
+	}
+
+	for i, test := range tests {
+		dst := []string{"old"}
+		scanner := CSVScanner{Dst: &dst, Sep: ",", SkipEmpty: test.skipEmpty}
+		if err := scanner.Scan(test.src); err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		} else if !slices.Equal(test.expect, dst) {
+			t.Errorf("%d: expect %q, but got %q", i, test.expect, dst)
+		}
+	}
+
+	var dst []string
+	if err := CSVStrings("|", &dst).Scan("a|b"); err != nil {
+		t.Error(err)
+	} else if expect := []string{"a", "b"}; !slices.Equal(expect, dst) {
+		t.Errorf("expect %q, but got %q", expect, dst)
+	}
+
+	if err := CSVStrings(",", &dst).Scan(123); err == nil {
+		t.Errorf("expect an error, but got nil")
+	}
+}
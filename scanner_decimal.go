@@ -0,0 +1,110 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+var (
+	_ sql.Scanner   = new(Decimal)
+	_ driver.Valuer = Decimal("")
+)
+
+// Decimal is an exact decimal number backed by its string representation,
+// such as "123.45", which is used to scan the NUMERIC or DECIMAL column,
+// such as the money, without the rounding of float64.
+//
+// The empty Decimal represents NULL.
+type Decimal string
+
+// ParseDecimal parses the decimal string s, such as "-123.45".
+func ParseDecimal(s string) (Decimal, error) {
+	if !isDecimalString(s) {
+		return "", fmt.Errorf("sqlx: invalid decimal '%s'", s)
+	}
+	return Decimal(s), nil
+}
+
+// String returns the string representation of the decimal.
+func (d Decimal) String() string { return string(d) }
+
+// Rat converts the decimal to a big.Rat for the exact arithmetic.
+//
+// If the decimal is empty or invalid, return (nil, false).
+func (d Decimal) Rat() (*big.Rat, bool) {
+	if d == "" {
+		return nil, false
+	}
+	return new(big.Rat).SetString(string(d))
+}
+
+// Scan implements the interface sql.Scanner.
+func (d *Decimal) Scan(src any) (err error) {
+	switch v := src.(type) {
+	case nil:
+		*d = ""
+
+	case string:
+		*d, err = ParseDecimal(v)
+
+	case []byte:
+		*d, err = ParseDecimal(string(v))
+
+	case int64:
+		*d = Decimal(strconv.FormatInt(v, 10))
+
+	case float64: // Some drivers return the decimal column as float64.
+		*d = Decimal(strconv.FormatFloat(v, 'f', -1, 64))
+
+	default:
+		err = fmt.Errorf("converting %T to sqlx.Decimal is unsupported", src)
+	}
+	return
+}
+
+// Value implements the interface driver.Valuer,
+// which returns the string representation, or nil if empty.
+func (d Decimal) Value() (driver.Value, error) {
+	if d == "" {
+		return nil, nil
+	}
+	return string(d), nil
+}
+
+func isDecimalString(s string) bool {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+
+	var digits, dots int
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.':
+			if dots++; dots > 1 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "testing"
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		src    any
+		expect Decimal
+		err    bool
+	}{
+		{nil, "", false},
+		{"12345678901234567890.12345678", "12345678901234567890.12345678", false},
+		{[]byte("-0.10"), "-0.10", false},
+		{int64(100), "100", false},
+		{float64(1.5), "1.5", false},
+		{"1.2.3", "", true},
+		{"abc", "", true},
+		{true, "", true},
+	}
+
+	for i, test := range tests {
+		var d Decimal = "1"
+		err := (GeneralScanner{Value: &d}).Scan(test.src)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expect an error, but got nil", i)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		} else if d != test.expect {
+			t.Errorf("%d: expect decimal '%s', but got '%s'", i, test.expect, d)
+		}
+	}
+
+	if v, _ := Decimal("").Value(); v != nil {
+		t.Errorf("expect nil value, but got %v", v)
+	}
+	if v, _ := Decimal("0.30").Value(); v != "0.30" {
+		t.Errorf("expect value '0.30', but got %v", v)
+	}
+
+	if r, ok := Decimal("0.1").Rat(); !ok || r.String() != "1/10" {
+		t.Errorf("expect rat 1/10, but got %v", r)
+	}
+}
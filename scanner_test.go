@@ -0,0 +1,121 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGeneralScannerBool(t *testing.T) {
+	tests := []struct {
+		src    any
+		strict bool
+		expect bool
+		fail   bool
+	}{
+		{int64(0), true, false, false},
+		{int64(1), true, true, false},
+		{int64(2), true, false, true},
+		{float64(2), true, false, true},
+		{int64(2), false, true, false},
+		{int64(0), false, false, false},
+	}
+
+	for i, test := range tests {
+		var v bool
+		err := GeneralScanner{Value: &v, Strict: test.strict}.Scan(test.src)
+		if test.fail {
+			if err == nil {
+				t.Errorf("%d: expect an error, but got nil", i)
+			}
+		} else if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		} else if v != test.expect {
+			t.Errorf("%d: expect %v, but got %v", i, test.expect, v)
+		}
+	}
+}
+
+func TestGeneralScannerBytes(t *testing.T) {
+	src := []byte(`{"a":1}`)
+
+	var raw json.RawMessage
+	if err := (GeneralScanner{Value: &raw}).Scan(src); err != nil {
+		t.Fatal(err)
+	} else if src[0] = '['; string(raw) != `{"a":1}` {
+		t.Errorf("expect the copied bytes, but got '%s'", raw)
+	}
+
+	tests := []struct {
+		src    any
+		expect []byte
+	}{
+		{`{"b":2}`, []byte(`{"b":2}`)},
+		{[]byte("abc"), []byte("abc")},
+		{int64(123), []byte("123")},
+		{true, []byte("true")},
+	}
+
+	for _, test := range tests {
+		var b []byte
+		if err := (GeneralScanner{Value: &b}).Scan(test.src); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if !bytes.Equal(test.expect, b) {
+			t.Errorf("expect '%s', but got '%s'", test.expect, b)
+		}
+	}
+	b := []byte("abc")
+	if err := (GeneralScanner{Value: &b}).Scan(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if b != nil {
+		t.Errorf("expect nil bytes for NULL, but got '%s'", b)
+	}
+
+	raw = json.RawMessage(`{"a":1}`)
+	if err := (GeneralScanner{Value: &raw}).Scan(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if raw != nil {
+		t.Errorf("expect nil json.RawMessage for NULL, but got '%s'", raw)
+	}
+}
+
+func TestGeneralScannerJSON(t *testing.T) {
+	type Attrs struct {
+		Color string `json:"color"`
+	}
+
+	var attrs Attrs
+	if err := (GeneralScanner{Value: &attrs}).Scan([]byte(`{"color":"red"}`)); err != nil {
+		t.Fatal(err)
+	} else if attrs.Color != "red" {
+		t.Errorf("expect color '%s', but got '%s'", "red", attrs.Color)
+	}
+
+	var m map[string]int
+	if err := (GeneralScanner{Value: &m}).Scan(`{"a":1}`); err != nil {
+		t.Fatal(err)
+	} else if expect := map[string]int{"a": 1}; !reflect.DeepEqual(expect, m) {
+		t.Errorf("expect %v, but got %v", expect, m)
+	}
+
+	var ints []int
+	err := (GeneralScanner{Value: &ints}).Scan(`[1, "a"]`)
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("expect the json unmarshal error, but got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/xgfone/go-defaults"
+)
+
+var (
+	_ sql.Scanner   = new(NullableTime)
+	_ driver.Valuer = NullableTime{}
+
+	_ sql.Scanner   = new(NullTime)
+	_ driver.Valuer = NullTime{}
+)
+
+// NullableTime is a nullable time, which distinguishes NULL from ZERO.
+//
+// Different from NullTime, only NULL is scanned as Valid=false,
+// and the zero time string, such as "0000-00-00 00:00:00",
+// is scanned as the zero time with Valid=true.
+type NullableTime struct {
+	Time  time.Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the interface sql.Scanner.
+func (t *NullableTime) Scan(src any) (err error) {
+	if src == nil {
+		t.Time, t.Valid = time.Time{}, false
+		return
+	}
+
+	t.Time, err = toTime(src, defaults.TimeLocation.Get())
+	t.Valid = err == nil
+	return
+}
+
+// Value implements the interface driver.Valuer, which returns nil if invalid.
+func (t NullableTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// NullTime is the same as sql.NullTime, but parses the time like GeneralScanner,
+// which supports the zero date of MySQL, such as "0000-00-00 00:00:00".
+//
+// Different from NullableTime, both NULL and the zero time are scanned
+// as Valid=false.
+type NullTime NullableTime
+
+// Scan implements the interface sql.Scanner.
+func (t *NullTime) Scan(src any) (err error) {
+	if err = (*NullableTime)(t).Scan(src); err == nil && t.Valid {
+		t.Valid = !IsZeroTime(t.Time)
+	}
+	return
+}
+
+// Value implements the interface driver.Valuer, which returns nil if invalid.
+func (t NullTime) Value() (driver.Value, error) {
+	return NullableTime(t).Value()
+}
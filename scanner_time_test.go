@@ -0,0 +1,100 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xgfone/go-defaults"
+)
+
+func TestNullableTime(t *testing.T) {
+	loc := defaults.TimeLocation.Get()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, loc)
+
+	tests := []struct {
+		src   any
+		valid bool
+		time  time.Time
+	}{
+		{nil, false, time.Time{}},
+		{"0000-00-00 00:00:00", true, time.Time{}},
+		{[]byte(""), true, time.Time{}},
+		{"2025-01-02 03:04:05", true, now},
+		{now, true, now},
+	}
+
+	for i, test := range tests {
+		nt := NullableTime{Time: time.Now(), Valid: true}
+		if err := (GeneralScanner{Value: &nt}).Scan(test.src); err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+
+		if nt.Valid != test.valid {
+			t.Errorf("%d: expect valid %v, but got %v", i, test.valid, nt.Valid)
+		}
+		if !nt.Time.Equal(test.time) {
+			t.Errorf("%d: expect time %s, but got %s", i, test.time, nt.Time)
+		}
+	}
+
+	if v, _ := (NullableTime{}).Value(); v != nil {
+		t.Errorf("expect nil value, but got %v", v)
+	}
+	if v, _ := (NullableTime{Time: now, Valid: true}).Value(); v != now {
+		t.Errorf("expect value %v, but got %v", now, v)
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	loc := defaults.TimeLocation.Get()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, loc)
+
+	tests := []struct {
+		src   any
+		valid bool
+		time  time.Time
+	}{
+		{nil, false, time.Time{}},
+		{"0000-00-00 00:00:00", false, time.Time{}},
+		{[]byte("0000-00-00 00:00:00.000"), false, time.Time{}},
+		{"2025-01-02 03:04:05", true, now},
+		{now, true, now},
+	}
+
+	for i, test := range tests {
+		nt := NullTime{Time: time.Now(), Valid: true}
+		if err := (GeneralScanner{Value: &nt}).Scan(test.src); err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+
+		if nt.Valid != test.valid {
+			t.Errorf("%d: expect valid %v, but got %v", i, test.valid, nt.Valid)
+		}
+		if !nt.Time.Equal(test.time) {
+			t.Errorf("%d: expect time %s, but got %s", i, test.time, nt.Time)
+		}
+	}
+
+	if v, _ := (NullTime{}).Value(); v != nil {
+		t.Errorf("expect nil value, but got %v", v)
+	}
+	if v, _ := (NullTime{Time: now, Valid: true}).Value(); v != now {
+		t.Errorf("expect value %v, but got %v", now, v)
+	}
+}
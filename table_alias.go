@@ -0,0 +1,85 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import "fmt"
+
+// Aliases is a registry of the table aliases shared by a complex query,
+// which qualifies the columns by the registered aliases to avoid the typos
+// referring to the same alias in SELECT, JOIN, WHERE and ORDER BY.
+//
+// Example:
+//
+//	aliases := NewAliases()
+//	u := aliases.Table("user", "u")
+//	o := aliases.Table("order", "o")
+//	Select(u.Col("name")).Select(o.Col("amount")).FromAlias(u.Name, u.Alias).
+//		JoinLeft(o.Name, o.Alias, On(u.Col("id"), o.Col("user_id")))
+type Aliases struct {
+	tables map[string]string // alias => table
+}
+
+// NewAliases returns a new registry of the table aliases.
+func NewAliases() *Aliases {
+	return &Aliases{tables: make(map[string]string, 4)}
+}
+
+// Table registers the table with the alias and returns it.
+//
+// If the alias has been registered for another table, it will panic.
+func (a *Aliases) Table(table, alias string) TableAlias {
+	if table == "" || alias == "" {
+		panic("sqlx.Aliases: the table and alias must not be empty")
+	}
+
+	if t, ok := a.tables[alias]; !ok {
+		a.tables[alias] = table
+	} else if t != table {
+		panic(fmt.Errorf("sqlx.Aliases: the alias '%s' has been registered for the table '%s'", alias, t))
+	}
+
+	return TableAlias{Name: table, Alias: alias}
+}
+
+// Get returns the table registered with the alias.
+//
+// If the alias has not been registered, it will panic.
+func (a *Aliases) Get(alias string) TableAlias {
+	table, ok := a.tables[alias]
+	if !ok {
+		panic(fmt.Errorf("sqlx.Aliases: the alias '%s' has not been registered", alias))
+	}
+	return TableAlias{Name: table, Alias: alias}
+}
+
+// TableAlias is a table with its alias, which produces the qualified columns.
+type TableAlias struct {
+	Name  string
+	Alias string
+}
+
+// Col returns the column qualified by the alias, that's, "alias.column".
+func (t TableAlias) Col(column string) string {
+	return t.Alias + "." + column
+}
+
+// Cols returns the columns qualified by the alias.
+func (t TableAlias) Cols(columns ...string) []string {
+	cols := make([]string, len(columns))
+	for i, column := range columns {
+		cols[i] = t.Col(column)
+	}
+	return cols
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestAliases(t *testing.T) {
+	aliases := NewAliases()
+	u := aliases.Table("users", "u")
+	o := aliases.Table("orders", "o")
+
+	sql, args := Selects(u.Cols("id", "name")...).SelectAlias(Sum(o.Col("amount")), "total").
+		FromAlias(u.Name, u.Alias).
+		JoinLeft(o.Name, o.Alias, On(u.Col("id"), aliases.Get("o").Col("user_id"))).
+		Where(op.Equal(u.Col("status"), 1)).
+		GroupBy(u.Col("id")).
+		OrderByDesc(o.Col("created_at")).
+		Build()
+
+	expect := "SELECT `u`.`id`, `u`.`name`, SUM(`o`.`amount`) AS `total` FROM `users` AS `u` " +
+		"LEFT JOIN `orders` AS `o` ON `u`.`id`=`o`.`user_id` WHERE `u`.`status`=? GROUP BY `u`.`id` ORDER BY `o`.`created_at` DESC"
+	if sql != expect {
+		t.Errorf("expect sql '%s', but got '%s'", expect, sql)
+	}
+	if expect := []any{1}; !slices.Equal(expect, args.Args()) {
+		t.Errorf("expect args %v, but got %v", expect, args.Args())
+	}
+
+	for _, f := range []func(){
+		func() { aliases.Table("products", "o") },
+		func() { aliases.Get("x") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expect a panic, but got not")
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// TxBeginner is used to begin a transaction, such as *sql.DB or *sql.Conn.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// txExecutor is the executor bound to a transaction.
+type txExecutor struct{ *sql.Tx }
+
+// Close does nothing, because the transaction is finished by Commit or Rollback.
+func (e txExecutor) Close() error { return nil }
+
+// connTx is the transaction started by the statement on a single connection,
+// which is used as both the executor and the transaction.
+//
+// It only forwards the methods of Executor to the connection,
+// so it is not regarded as a TxBeginner or a preparer.
+type connTx struct{ conn *sql.Conn }
+
+// Close does nothing, because the connection is released by Commit or Rollback.
+func (tx connTx) Close() error { return nil }
+
+func (tx connTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return tx.conn.ExecContext(ctx, query, args...)
+}
+
+func (tx connTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return tx.conn.QueryContext(ctx, query, args...)
+}
+
+func (tx connTx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return tx.conn.QueryRowContext(ctx, query, args...)
+}
+
+func (tx connTx) Commit() error   { return tx.finish("COMMIT") }
+func (tx connTx) Rollback() error { return tx.finish("ROLLBACK") }
+
+func (tx connTx) finish(stmt string) error {
+	_, err := tx.conn.ExecContext(context.Background(), stmt)
+	if cerr := tx.conn.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Tx is a transaction, which embeds the copy of DB bound to the transaction,
+// so the builders created by it, such as Select, Insert, Update and Delete,
+// execute the statements in the transaction.
+type Tx struct {
+	*DB
+	tx interface {
+		Commit() error
+		Rollback() error
+	}
+}
+
+// Commit commits the transaction.
+//
+// If the transaction is joined to an outer transaction, do nothing.
+func (tx *Tx) Commit() error {
+	if tx.tx == nil {
+		return nil
+	}
+	return tx.tx.Commit()
+}
+
+// Rollback aborts the transaction.
+//
+// If the transaction is joined to an outer transaction, do nothing.
+func (tx *Tx) Rollback() error {
+	if tx.tx == nil {
+		return nil
+	}
+	return tx.tx.Rollback()
+}
+
+// InTransaction reports whether the db has been bound to a transaction.
+func (db *DB) InTransaction() bool {
+	switch getDB(db).Executor.(type) {
+	case txExecutor, connTx:
+		return true
+	default:
+		return false
+	}
+}
+
+// Begin is equal to db.BeginTx(ctx, nil).
+func (db *DB) Begin(ctx context.Context) (*Tx, error) {
+	return db.BeginTx(ctx, nil)
+}
+
+// BeginTx begins a transaction with the options, which must be finished
+// by Commit or Rollback.
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	db = getDB(db)
+	beginner, ok := db.Executor.(TxBeginner)
+	if !ok {
+		return nil, fmt.Errorf("sqlx: the executor %T does not support the transaction", db.Executor)
+	}
+
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{DB: db.withExecutor(txExecutor{tx}), tx: tx}, nil
+}
+
+// Transaction is equal to db.TransactionWithOptions(ctx, nil, fn).
+func (db *DB) Transaction(ctx context.Context, fn func(tx *Tx) error) error {
+	return db.TransactionWithOptions(ctx, nil, fn)
+}
+
+// TransactionWithOptions begins a transaction with the options
+// and calls the function fn with it.
+//
+// If fn returns an error or panics, the transaction will be rolled back.
+// Or, it will be committed.
+//
+// If db has been bound to a transaction, fn is called with the transaction
+// joining the outer one, whose Commit and Rollback do nothing.
+func (db *DB) TransactionWithOptions(ctx context.Context, opts *sql.TxOptions, fn func(tx *Tx) error) (err error) {
+	db = getDB(db)
+	if db.InTransaction() {
+		return fn(&Tx{DB: db})
+	}
+
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return
+	}
+	return runTx(tx, fn)
+}
+
+// TransactionConsistentSnapshot is the same as Transaction, but begins
+// the transaction by "START TRANSACTION WITH CONSISTENT SNAPSHOT" for MySQL,
+// which is useful for the reporting transaction to read a consistent view.
+//
+// For other dialects, it falls back to Transaction.
+//
+// Notice: for MySQL, the executor of db must support to acquire
+// a single connection, such as *sql.DB.
+func (db *DB) TransactionConsistentSnapshot(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	db = getDB(db)
+	if db.GetDialect().Name() != mysqlDialect {
+		return db.Transaction(ctx, fn)
+	} else if db.InTransaction() {
+		return fn(&Tx{DB: db})
+	}
+
+	getter, ok := db.Executor.(interface {
+		Conn(context.Context) (*sql.Conn, error)
+	})
+	if !ok {
+		return fmt.Errorf("sqlx: the executor %T does not support to acquire a connection", db.Executor)
+	}
+
+	conn, err := getter.Conn(ctx)
+	if err != nil {
+		return
+	}
+
+	if _, err = conn.ExecContext(ctx, "START TRANSACTION WITH CONSISTENT SNAPSHOT"); err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	tx := connTx{conn}
+	return runTx(&Tx{DB: db.withExecutor(tx), tx: tx}, fn)
+}
+
+// runTx calls the function fn with the transaction tx,
+// then commits it if fn returns nil, or rolls it back.
+func runTx(tx *Tx, fn func(tx *Tx) error) (err error) {
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = fn(tx); err == nil {
+		committed = true
+		err = tx.Commit()
+	}
+	return
+}
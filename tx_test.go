@@ -0,0 +1,166 @@
+// Copyright 2025 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/xgfone/go-op"
+)
+
+func TestOperTxRollback(t *testing.T) {
+	type User struct {
+		Name string `sql:"name"`
+	}
+	type Order struct {
+		UserName string `sql:"user_name"`
+	}
+
+	db, tdb := newTestDB(MySQL, nil)
+	users := NewOperWithTable[User](db.NewTable("user"))
+	orders := NewOperWithTable[Order](db.NewTable("order"))
+
+	errfail := errors.New("fail")
+	ctx := context.Background()
+	err := db.Transaction(ctx, func(tx *Tx) error {
+		if err := users.Tx(tx.DB).AddContext(ctx, User{Name: "abc"}); err != nil {
+			return err
+		}
+		if err := orders.Tx(tx.DB).AddContext(ctx, Order{UserName: "abc"}); err != nil {
+			return err
+		}
+		return errfail
+	})
+	if !errors.Is(err, errfail) {
+		t.Errorf("expect error '%v', but got '%v'", errfail, err)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"INSERT INTO `user` (`name`) VALUES (?)",
+		"INSERT INTO `order` (`user_name`) VALUES (?)",
+		"ROLLBACK",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestDBTransactionCommit(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+	err := db.Transaction(context.Background(), func(tx *Tx) error {
+		if !tx.InTransaction() {
+			t.Errorf("expect the db bound to a transaction")
+		}
+
+		// Join the outer transaction.
+		return tx.Transaction(context.Background(), func(tx *Tx) error {
+			_, err := tx.Exec("DELETE FROM `user`")
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{"BEGIN", "DELETE FROM `user`", "COMMIT"}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestDBBegin(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+
+	tx, err := db.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Update().Table("user").Set(op.Set("age", 18)).Where(op.Equal("id", 1)).Exec(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = db.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Delete().From("user").Where(op.Equal("id", 2)).Exec(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{
+		"BEGIN",
+		"UPDATE `user` SET `age`=? WHERE `id`=?",
+		"COMMIT",
+		"BEGIN",
+		"DELETE FROM `user` WHERE `id`=?",
+		"ROLLBACK",
+	}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
+
+func TestDBTransactionConsistentSnapshot(t *testing.T) {
+	db, tdb := newTestDB(MySQL, nil)
+	err := db.TransactionConsistentSnapshot(context.Background(), func(tx *Tx) error {
+		if !tx.InTransaction() {
+			t.Errorf("expect the db bound to a transaction")
+		}
+		if _, ok := tx.Executor.(TxBeginner); ok {
+			t.Errorf("expect the connection-scoped transaction not to be a TxBeginner")
+		}
+		if _, ok := tx.Executor.(interface {
+			PrepareContext(context.Context, string) (*sql.Stmt, error)
+		}); ok {
+			t.Errorf("expect the connection-scoped transaction not to be a preparer")
+		}
+
+		var ids []int
+		return tx.Select("id").From("user").QueryRows().Bind(&ids)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := []string{"START TRANSACTION WITH CONSISTENT SNAPSHOT", "SELECT `id` FROM `user`", "COMMIT"}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+
+	db, tdb = newTestDB(Postgres, nil)
+	err = db.TransactionConsistentSnapshot(context.Background(), func(tx *Tx) error {
+		_, err := tx.Exec(`DELETE FROM "user"`)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects = []string{"BEGIN", `DELETE FROM "user"`, "COMMIT"}
+	if stmts := tdb.Statements(); !slices.Equal(expects, stmts) {
+		t.Errorf("expect statements %q, but got %q", expects, stmts)
+	}
+}
@@ -36,8 +36,10 @@ func getBuffer() *bytes.Buffer    { return bufpool.Get().(*bytes.Buffer) }
 func putBuffer(buf *bytes.Buffer) { buf.Reset(); bufpool.Put(buf) }
 
 var (
-	_timetype   = reflect.TypeFor[time.Time]()
-	_valuertype = reflect.TypeFor[driver.Valuer]()
+	_timetype    = reflect.TypeFor[time.Time]()
+	_bytestype   = reflect.TypeFor[[]byte]()
+	_valuertype  = reflect.TypeFor[driver.Valuer]()
+	_scannertype = reflect.TypeFor[sql.Scanner]()
 )
 
 // IsPointerToStruct returns true if v is a pointer to struct, else false.
@@ -77,11 +79,34 @@ func CheckErrNoRows(err error) (exist bool, e error) {
 	return
 }
 
+// IsZeroTime is used to decide whether the time.Time field with the tag
+// "omitempty" or "omitzero" is ZERO when inserting the struct.
+//
+// For example, treat the time not after the year 1 as ZERO:
+//
+//	sqlx.IsZeroTime = func(t time.Time) bool { return t.Year() <= 1 }
+//
+// Default: t.IsZero()
+var IsZeroTime = func(t time.Time) bool { return t.IsZero() }
+
+func isZeroValue(v any) bool {
+	return v == nil || isZero(reflect.ValueOf(v))
+}
+
 func isZero(v reflect.Value) bool {
 	if v.IsZero() {
 		return true
 	}
 
+	switch t := v.Interface().(type) {
+	case time.Time:
+		return IsZeroTime(t)
+	case *time.Time:
+		// A non-nil *time.Time has the method IsZero, so it has been regarded
+		// as ZERO when pointing to the zero time. Use IsZeroTime as time.Time.
+		return IsZeroTime(*t)
+	}
+
 	if i, ok := v.Interface().(interface{ IsZero() bool }); ok {
 		return i.IsZero()
 	}
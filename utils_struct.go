@@ -32,8 +32,11 @@ type (
 		Indexes []int
 		TagArgs []string
 
+		IsJSON     bool
 		IsValuer   bool
 		IgnoreZero bool
+		Immutable  bool   // Not updated by upsert
+		Setter     string // The method name to set the scanned value
 	}
 )
 
@@ -67,10 +70,17 @@ func getFieldExtracter(vtype reflect.Type, get func(reflect.Type) fieldExtracter
 }
 
 func extractStructFields(fields []structfield, vtype reflect.Type) []structfield {
-	return _extractStructFields(fields, vtype, "", nil)
+	return _extractStructFields(fields, vtype, "", nil, false)
 }
 
-func _extractStructFields(fields []structfield, vtype reflect.Type, prefix string, indexes []int) []structfield {
+// extractScannedStructFields is the same as extractStructFields,
+// but also extracts the nested struct field as a whole column,
+// which will be decoded from JSON if it is not a sql.Scanner.
+func extractScannedStructFields(fields []structfield, vtype reflect.Type) []structfield {
+	return _extractStructFields(fields, vtype, "", nil, true)
+}
+
+func _extractStructFields(fields []structfield, vtype reflect.Type, prefix string, indexes []int, scan bool) []structfield {
 	_len := vtype.NumField()
 	for i := 0; i < _len; i++ {
 		ftype := vtype.Field(i)
@@ -99,15 +109,28 @@ func _extractStructFields(fields []structfield, vtype reflect.Type, prefix strin
 
 		isvaluer := ftype.Type.Implements(_valuertype)
 		if !isvaluer && ftype.Type.Kind() == reflect.Struct && ftype.Type != _timetype {
-			fields = _extractStructFields(fields, ftype.Type, formatFieldName(prefix, tname), _indexes)
+			_prefix := formatFieldName(prefix, structPrefix(ftype, tname, targs))
+			if scan && _prefix != "" {
+				fields = append(fields, structfield{
+					Column:  _prefix,
+					Indexes: _indexes,
+					TagArgs: targs,
+					IsJSON:  !isscanner(ftype.Type),
+					Setter:  tagArgValue(targs, "setter"),
+				})
+			}
+			fields = _extractStructFields(fields, ftype.Type, _prefix, _indexes, scan)
 		} else {
 			fields = append(fields, structfield{
 				Column:  formatFieldName(prefix, name),
 				Indexes: _indexes,
 				TagArgs: targs,
 
+				IsJSON:     isjsontype(ftype.Type),
 				IsValuer:   isvaluer,
 				IgnoreZero: slices.ContainsFunc(targs, ignorezero),
+				Immutable:  slices.Contains(targs, "immutable"),
+				Setter:     tagArgValue(targs, "setter"),
 			})
 		}
 	}
@@ -115,6 +138,52 @@ func _extractStructFields(fields []structfield, vtype reflect.Type, prefix strin
 	return fields
 }
 
+// structPrefix returns the prefix of the columns of the nested struct field,
+// which is the tag name, or the field name if the tag name is empty and
+// the field is not embedded. So the embedded struct without the tag name
+// is flattened without the prefix.
+//
+// But the tag argument "prefix=xxx", such as `sql:",prefix=addr_"`,
+// overrides it, and the trailing Sep of the prefix is optional.
+func structPrefix(field reflect.StructField, tname string, targs []string) string {
+	if prefix := tagArgValue(targs, "prefix"); prefix != "" {
+		return strings.TrimSuffix(prefix, Sep)
+	}
+	if tname == "" && !field.Anonymous {
+		return field.Name
+	}
+	return tname
+}
+
+// isjsontype reports whether the value of the type t is decoded from JSON,
+// that's, t is a map or non-[]byte slice and does not implement sql.Scanner.
+func isjsontype(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Map:
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+	default:
+		return false
+	}
+	return !isscanner(t)
+}
+
+func isscanner(t reflect.Type) bool {
+	return t.Implements(_scannertype) || reflect.PointerTo(t).Implements(_scannertype)
+}
+
+// tagArgValue returns the value of the tag argument formatted as "key=value".
+func tagArgValue(targs []string, key string) string {
+	for _, arg := range targs {
+		if k, v, ok := strings.Cut(arg, "="); ok && strings.TrimSpace(k) == key {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
 func ignorezero(s string) bool { return s == "omitempty" || s == "omitzero" }
 
 func formatFieldName(prefix, name string) string {